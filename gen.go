@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+
+	"qgames/generator"
+)
+
+func gen(args []string) {
+	cfg := generator.DefaultConfig()
+
+	// Define flags
+	var outFile string
+	var reportFile string
+
+	// Parse command-line arguments
+	flags := flag.NewFlagSet("gen", flag.ExitOnError)
+	flags.StringVar(&outFile, "out", "generated.log", "Output log file name")
+	flags.StringVar(&reportFile, "report", "", "Output file of the report expected of the log, optional")
+	flags.Int64Var(&cfg.Seed, "seed", cfg.Seed, "Seed of the generation, the same seed generating the same log")
+	flags.IntVar(&cfg.Games, "games", cfg.Games, "Number of games")
+	flags.IntVar(&cfg.Players, "players", cfg.Players, "Number of players joining every game")
+	flags.IntVar(&cfg.Minutes, "minutes", cfg.Minutes, "Game time of every game, in minutes")
+	flags.Float64Var(&cfg.KillRate, "kill-rate", cfg.KillRate, "Kills by minute")
+	flags.Float64Var(&cfg.RenameRate, "rename-rate", cfg.RenameRate, "Renames by minute")
+	flags.Float64Var(&cfg.DisconnectRate, "disconnect-rate", cfg.DisconnectRate, "Disconnects by minute, most players reconnecting later")
+	flags.Float64Var(&cfg.Malformed, "malformed", cfg.Malformed, "Share of games with a malformed line, dropped by the parser")
+	flags.Float64Var(&cfg.TeamGames, "team-games", cfg.TeamGames, "Share of games played in teams, half of them capture the flag")
+	_ = flags.Parse(args)
+
+	f, err := os.Create(outFile)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	report, err := generator.Generate(f, cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	if reportFile != "" {
+		out, _ := json.Marshal(report)
+		if err := writeOutputToFile(reportFile, string(out)); err != nil {
+			panic(err)
+		}
+	}
+}
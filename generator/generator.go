@@ -0,0 +1,336 @@
+// Package generator writes synthetic Quake 3 Arena logs, deterministically from a seed, along
+// with the report the parser is expected to make of them, for property and load tests.
+package generator
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+
+	"qgames/parser"
+)
+
+type (
+	// Config shapes the generated logs; rates are by minute of game time.
+	Config struct {
+		// Seed makes the generation deterministic: the same config always generates the same log.
+		Seed int64
+		// Games is the number of games of the log.
+		Games int
+		// Players is the number of players joining every game.
+		Players int
+		// Minutes is the game time of every game.
+		Minutes int
+		// KillRate is the number of kills by minute.
+		KillRate float64
+		// RenameRate is the number of renames by minute.
+		RenameRate float64
+		// DisconnectRate is the number of disconnects by minute, most players reconnecting later.
+		DisconnectRate float64
+		// Malformed is the share of games with a malformed line, which the parser drops.
+		Malformed float64
+		// TeamGames is the share of games played in teams, half of them capture the flag.
+		TeamGames float64
+	}
+
+	// Report is the report expected of the parser for a generated log, keyed by game_XX.
+	Report map[string]Game
+
+	// Game is the expected report of a game, its fields as the parser names them.
+	Game struct {
+		Map          string         `json:"map"`
+		TotalKills   int            `json:"total_kills"`
+		Players      []string       `json:"players"`
+		Kills        map[string]int `json:"kills"`
+		KillsByMeans map[string]int `json:"kills_by_means"`
+	}
+
+	// name is a player name as logged and as the parser names the player.
+	name struct {
+		logged string
+		clean  string
+	}
+
+	// client is a player connected to the game, by client ID.
+	client struct {
+		id   int
+		name name
+		team int
+	}
+
+	generator struct {
+		cfg    Config
+		rand   *rand.Rand
+		w      *bufio.Writer
+		time   int
+		game   Game
+		online []*client
+		// offline are the clients who left the game and may come back.
+		offline []*client
+		nextID  int
+	}
+)
+
+// DefaultConfig returns a config generating a log similar to qgames.log.
+func DefaultConfig() Config {
+	return Config{
+		Seed:           1,
+		Games:          20,
+		Players:        6,
+		Minutes:        15,
+		KillRate:       6,
+		RenameRate:     0.1,
+		DisconnectRate: 0.1,
+		Malformed:      0.05,
+		TeamGames:      0.3,
+	}
+}
+
+const (
+	worldID   = 1022
+	firstID   = 2
+	worldName = "<world>"
+)
+
+var (
+	names = []name{
+		{"Isgalamido", "Isgalamido"},
+		{"Dono da Bola", "Dono da Bola"},
+		{"Mocinha", "Mocinha"},
+		{"Zeh", "Zeh"},
+		{"Assasinu Credi", "Assasinu Credi"},
+		{"Oootsimo", "Oootsimo"},
+		{"Chessus", "Chessus"},
+		{"Chessus!", "Chessus!"},
+		{"Mal", "Mal"},
+		{"Maluquinho", "Maluquinho"},
+		{"Fasano Again", "Fasano Again"},
+		{"UnnamedPlayer", "UnnamedPlayer"},
+		{"^1Red^7Name", "RedName"},
+		{"^4Blue ^7Steel", "Blue Steel"},
+	}
+
+	maps = []string{"q3dm17", "q3dm6", "q3dm7", "q3tourney2", "q3ctf1", "q3ctf4"}
+
+	// weapons are the means of death of the kills by a player, worldMeans those of the kills by <world>.
+	weapons    = []parser.MeansOfDeath{parser.ModShotgun, parser.ModGauntlet, parser.ModMachinegun, parser.ModGrenade, parser.ModGrenadeSplash, parser.ModRocket, parser.ModRocketSplash, parser.ModRocketSplash, parser.ModPlasma, parser.ModPlasmaSplash, parser.ModRailgun, parser.ModRailgun, parser.ModLightning, parser.ModBFG, parser.ModBFGSplash, parser.ModTelefrag}
+	worldMeans = []parser.MeansOfDeath{parser.ModTriggerHurt, parser.ModTriggerHurt, parser.ModFalling, parser.ModLava, parser.ModSlime, parser.ModWater, parser.ModCrush}
+	items      = []string{"weapon_rocketlauncher", "weapon_railgun", "weapon_shotgun", "ammo_rockets", "ammo_slugs", "item_armor_body", "item_armor_shard", "item_health", "item_health_large", "item_quad"}
+)
+
+// Generate writes a log of the config to w and returns the report expected of it.
+func Generate(w io.Writer, cfg Config) (Report, error) {
+	g := generator{cfg: cfg, rand: rand.New(rand.NewSource(cfg.Seed)), w: bufio.NewWriter(w)}
+	report := make(Report, cfg.Games)
+	for number := 1; number <= cfg.Games; number++ {
+		if game, ok := g.generateGame(); ok {
+			report[fmt.Sprintf("game_%02d", number)] = game
+		}
+	}
+	return report, g.w.Flush()
+}
+
+// generateGame writes a game, false when it has a malformed line.
+func (g *generator) generateGame() (Game, bool) {
+	gameType := 0
+	if g.rand.Float64() < g.cfg.TeamGames {
+		gameType = parser.GameTypeTeam + g.rand.Intn(2)
+	}
+	g.time = 0
+	g.game = Game{
+		Map:          maps[g.rand.Intn(len(maps))],
+		Players:      make([]string, 0),
+		Kills:        make(map[string]int),
+		KillsByMeans: make(map[string]int),
+	}
+	g.online, g.offline, g.nextID = nil, nil, firstID
+
+	g.line(`InitGame: \sv_floodProtect\1\sv_maxPing\0\sv_minPing\0\sv_maxRate\10000\sv_minRate\0\sv_hostname\Code Miner Server\g_gametype\%d\sv_privateClients\2\sv_maxclients\16\sv_allowDownload\0\dmflags\0\fraglimit\20\timelimit\%d\g_maxGameClients\0\capturelimit\8\version\ioq3 1.36 linux-x86_64 Apr 12 2009\protocol\68\mapname\%s\gamename\baseq3\g_needpass\0`,
+		gameType, g.cfg.Minutes, g.game.Map)
+	for i := 0; i < g.cfg.Players; i++ {
+		team := 0
+		if gameType >= parser.GameTypeTeam {
+			team = 1 + i%2
+		}
+		g.connect(&client{id: g.newID(), name: g.freeName(), team: team})
+	}
+
+	malformedAt := -1
+	if g.rand.Float64() < g.cfg.Malformed {
+		malformedAt = g.rand.Intn(g.cfg.Minutes*60 + 1)
+	}
+	for g.time = 0; g.time <= g.cfg.Minutes*60; g.time++ {
+		for i := g.count(g.cfg.KillRate); i > 0; i-- {
+			g.kill()
+		}
+		for i := g.count(g.cfg.RenameRate); i > 0; i-- {
+			g.rename()
+		}
+		for i := g.count(g.cfg.DisconnectRate); i > 0; i-- {
+			g.disconnect()
+		}
+		g.reconnect()
+		if g.rand.Intn(20) == 0 && len(g.online) > 0 {
+			g.line("Item: %d %s", g.online[g.rand.Intn(len(g.online))].id, items[g.rand.Intn(len(items))])
+		}
+		if g.time == malformedAt {
+			g.malformed()
+		}
+	}
+
+	g.time--
+	g.line("Exit: Timelimit hit.")
+	for _, c := range g.online {
+		g.line("score: %d  ping: %d  client: %d %s", g.game.Kills[c.name.clean], g.rand.Intn(100), c.id, c.name.logged)
+	}
+	g.line("ShutdownGame:")
+	g.line("------------------------------------------------------------")
+
+	for player, kills := range g.game.Kills {
+		if kills == 0 {
+			delete(g.game.Kills, player)
+		}
+	}
+	return g.game, malformedAt < 0
+}
+
+// count returns how many events of the rate by minute happen in a second.
+func (g *generator) count(rate float64) int {
+	perSecond := rate / 60
+	n := int(perSecond)
+	if g.rand.Float64() < perSecond-float64(n) {
+		n++
+	}
+	return n
+}
+
+func (g *generator) line(format string, args ...any) {
+	fmt.Fprintf(g.w, "%3d:%02d %s\n", g.time/60, g.time%60, fmt.Sprintf(format, args...))
+}
+
+func (g *generator) newID() int {
+	id := g.nextID
+	g.nextID++
+	return id
+}
+
+// freeName returns a name no connected player uses, a numbered one once the names run out.
+func (g *generator) freeName() name {
+	used := make(map[string]bool, len(g.online))
+	for _, c := range g.online {
+		used[c.name.clean] = true
+	}
+	free := make([]name, 0, len(names))
+	for _, n := range names {
+		if !used[n.clean] {
+			free = append(free, n)
+		}
+	}
+	if len(free) > 0 {
+		return free[g.rand.Intn(len(free))]
+	}
+	for i := len(names) + 1; ; i++ {
+		if n := fmt.Sprintf("Player %d", i); !used[n] {
+			return name{n, n}
+		}
+	}
+}
+
+func (g *generator) connect(c *client) {
+	g.line("ClientConnect: %d", c.id)
+	g.userinfo(c)
+	g.line("ClientBegin: %d", c.id)
+	g.online = append(g.online, c)
+}
+
+func (g *generator) userinfo(c *client) {
+	g.line(`ClientUserinfoChanged: %d n\%s\t\%d\model\sarge/default\hmodel\sarge/default\g_redteam\\g_blueteam\\c1\4\c2\5\hc\100\w\0\l\0\tt\0\tl\0`, c.id, c.name.logged, c.team)
+	g.addPlayer(c.name.clean)
+}
+
+func (g *generator) addPlayer(player string) {
+	for _, p := range g.game.Players {
+		if p == player {
+			return
+		}
+	}
+	g.game.Players = append(g.game.Players, player)
+}
+
+func (g *generator) rename() {
+	if len(g.online) == 0 {
+		return
+	}
+	c := g.online[g.rand.Intn(len(g.online))]
+	c.name = g.freeName()
+	g.userinfo(c)
+}
+
+func (g *generator) disconnect() {
+	if len(g.online) == 0 {
+		return
+	}
+	i := g.rand.Intn(len(g.online))
+	c := g.online[i]
+	g.online = append(g.online[:i], g.online[i+1:]...)
+	g.line("ClientDisconnect: %d", c.id)
+	if g.rand.Intn(4) > 0 {
+		g.offline = append(g.offline, c)
+	}
+}
+
+// reconnect brings back a player who left, now and then, under a new name if theirs was taken.
+func (g *generator) reconnect() {
+	if len(g.offline) == 0 || g.rand.Intn(30) > 0 {
+		return
+	}
+	c := g.offline[0]
+	g.offline = g.offline[1:]
+	for _, other := range g.online {
+		if other.name.clean == c.name.clean {
+			c.name = g.freeName()
+			break
+		}
+	}
+	g.connect(c)
+}
+
+// kill writes a kill by a player, by <world> or a suicide, scoring it as the parser does by default.
+func (g *generator) kill() {
+	if len(g.online) == 0 {
+		return
+	}
+	victim := g.online[g.rand.Intn(len(g.online))]
+
+	switch roll := g.rand.Intn(20); {
+	case roll < 3 || len(g.online) == 1:
+		means := worldMeans[g.rand.Intn(len(worldMeans))]
+		g.line("Kill: %d %d %d: %s killed %s by %s", worldID, victim.id, means, worldName, victim.name.logged, means)
+		g.game.Kills[victim.name.clean]--
+		g.game.KillsByMeans[means.String()]++
+	case roll < 4:
+		means := []parser.MeansOfDeath{parser.ModRocketSplash, parser.ModGrenadeSplash, parser.ModSuicide}[g.rand.Intn(3)]
+		g.line("Kill: %d %d %d: %s killed %s by %s", victim.id, victim.id, means, victim.name.logged, victim.name.logged, means)
+		g.game.KillsByMeans[means.String()]++
+	default:
+		killer := g.online[g.rand.Intn(len(g.online)-1)]
+		if killer == victim {
+			killer = g.online[len(g.online)-1]
+		}
+		means := weapons[g.rand.Intn(len(weapons))]
+		g.line("Kill: %d %d %d: %s killed %s by %s", killer.id, victim.id, means, killer.name.logged, victim.name.logged, means)
+		g.game.Kills[killer.name.clean]++
+		g.game.KillsByMeans[means.String()]++
+	}
+	g.game.TotalKills++
+}
+
+// malformed writes a line cut short, as when the server crashes mid-write.
+func (g *generator) malformed() {
+	if len(g.online) == 0 || g.rand.Intn(2) == 0 {
+		g.line("Kill: %d", worldID)
+		return
+	}
+	g.line("ClientUserinfoChanged: %d ", g.online[g.rand.Intn(len(g.online))].id)
+}
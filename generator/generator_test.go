@@ -0,0 +1,74 @@
+//go:build unit
+
+package generator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"qgames/parser"
+)
+
+func TestGenerate_Deterministic(t *testing.T) {
+	cfg := DefaultConfig()
+
+	var first, second, other bytes.Buffer
+	firstReport, err := Generate(&first, cfg)
+	assert.NoError(t, err)
+	secondReport, err := Generate(&second, cfg)
+	assert.NoError(t, err)
+	cfg.Seed++
+	_, err = Generate(&other, cfg)
+	assert.NoError(t, err)
+
+	assert.Equal(t, first.String(), second.String())
+	assert.Equal(t, firstReport, secondReport)
+	assert.NotEqual(t, first.String(), other.String())
+}
+
+func TestGenerate_ParsedAsReported(t *testing.T) {
+	tests := []struct {
+		name   string
+		config func(*Config)
+	}{
+		{name: "Default", config: func(*Config) {}},
+		{name: "Crowded", config: func(c *Config) { c.Players, c.KillRate = 20, 60 }},
+		{name: "Alone", config: func(c *Config) { c.Players = 1 }},
+		{name: "Renames and disconnects", config: func(c *Config) { c.RenameRate, c.DisconnectRate = 4, 4 }},
+		{name: "Malformed lines", config: func(c *Config) { c.Malformed = 0.5 }},
+		{name: "Team games", config: func(c *Config) { c.TeamGames = 1 }},
+		{name: "More games than two digits", config: func(c *Config) { c.Games, c.Minutes, c.KillRate = 120, 1, 2 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for seed := int64(1); seed <= 3; seed++ {
+				cfg := DefaultConfig()
+				cfg.Seed, cfg.Games, cfg.Minutes = seed, 10, 5
+				tt.config(&cfg)
+
+				var log bytes.Buffer
+				report, err := Generate(&log, cfg)
+				assert.NoError(t, err)
+
+				p := parser.Parser{}
+				games, err := p.ParseReader(&log)
+				assert.NoError(t, err)
+
+				assert.Len(t, games, len(report), "seed %d", seed)
+				for key, want := range report {
+					got, ok := games[key]
+					if !assert.True(t, ok, "seed %d: %s", seed, key) {
+						continue
+					}
+					assert.Equal(t, want.Map, got.Map, "seed %d: %s", seed, key)
+					assert.Equal(t, want.TotalKills, got.TotalKills, "seed %d: %s", seed, key)
+					assert.Equal(t, want.Players, got.Players, "seed %d: %s", seed, key)
+					assert.Equal(t, want.Kills, got.Kills, "seed %d: %s", seed, key)
+					assert.Equal(t, want.KillsByMeans, got.KillsByMeans, "seed %d: %s", seed, key)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package grpcserver
+
+import (
+	"encoding/json"
+
+	"qgames/parser"
+	"qgames/pb"
+)
+
+func toGame(game parser.Game) *pb.Game {
+	out := &pb.Game{
+		Map:             game.Map,
+		TotalKills:      int32(game.TotalKills),
+		Players:         game.Players,
+		Kills:           toCounts(game.Kills),
+		KillsByMeans:    toCounts(game.KillsByMeans),
+		ItemsByCategory: toCounts(game.ItemsByCategory),
+		KillsByFamily:   toCounts(game.KillsByFamily),
+		ItemsByPlayer:   make(map[string]*pb.ItemCounts, len(game.ItemsByPlayer)),
+		Connections:     make(map[string]*pb.Connection, len(game.Connections)),
+		KillMatrix:      make(map[string]*pb.Counts, len(game.KillMatrix)),
+		Names:           make(map[string]*pb.PlayerName, len(game.Names)),
+		Aggregates:      make(map[string]string, len(game.Aggregates)),
+	}
+
+	for key, result := range game.Aggregates {
+		encoded, err := json.Marshal(result)
+		if err != nil {
+			continue
+		}
+		out.Aggregates[key] = string(encoded)
+	}
+
+	for player, name := range game.Names {
+		out.Names[player] = &pb.PlayerName{DisplayName: name.DisplayName, CleanName: name.CleanName}
+	}
+
+	for player, items := range game.ItemsByPlayer {
+		out.ItemsByPlayer[player] = &pb.ItemCounts{Counts: toCounts(items)}
+	}
+	for _, message := range game.Chat {
+		out.Chat = append(out.Chat, &pb.Message{
+			Time:    int32(message.Time),
+			Player:  message.Player,
+			Message: message.Message,
+			Team:    message.Team,
+			To:      message.To,
+		})
+	}
+	for _, inconsistency := range game.Inconsistencies {
+		out.Inconsistencies = append(out.Inconsistencies, &pb.Inconsistency{
+			Time:     int32(inconsistency.Time),
+			Logged:   inconsistency.Logged,
+			Resolved: inconsistency.Resolved,
+		})
+	}
+	for player, conn := range game.Connections {
+		out.Connections[player] = &pb.Connection{
+			JoinedAt:     int32(conn.JoinedAt),
+			LeftAt:       int32(conn.LeftAt),
+			TimeOnServer: int32(conn.TimeOnServer),
+			Reconnects:   int32(conn.Reconnects),
+			PresentAtEnd: conn.PresentAtEnd,
+		}
+	}
+
+	for killer, victims := range game.KillMatrix {
+		out.KillMatrix[killer] = &pb.Counts{Counts: toCounts(victims)}
+	}
+
+	if game.Awards != nil {
+		out.Awards = &pb.Awards{
+			FirstBlood:  game.Awards.FirstBlood,
+			Streaks:     toCounts(game.Awards.Streaks),
+			MultiKills:  make(map[string]*pb.MultiKills, len(game.Awards.MultiKills)),
+			Dominations: make(map[string]*pb.Counts, len(game.Awards.Dominations)),
+		}
+		for player, multiKills := range game.Awards.MultiKills {
+			out.Awards.MultiKills[player] = &pb.MultiKills{
+				Double: int32(multiKills.Double),
+				Triple: int32(multiKills.Triple),
+				Quad:   int32(multiKills.Quad),
+			}
+		}
+		for killer, victims := range game.Awards.Dominations {
+			out.Awards.Dominations[killer] = &pb.Counts{Counts: toCounts(victims)}
+		}
+	}
+	if game.Teams != nil {
+		out.Teams = &pb.Teams{
+			Players:   game.Teams.Players,
+			Switches:  toCounts(game.Teams.Switches),
+			Kills:     toCounts(game.Teams.Kills),
+			TeamKills: toCounts(game.Teams.TeamKills),
+			Scores:    toCounts(game.Teams.Scores),
+		}
+	}
+	if game.CTF != nil {
+		out.Ctf = &pb.CTF{
+			Players: toFlagStats(game.CTF.Players),
+			Teams:   toFlagStats(game.CTF.Teams),
+		}
+	}
+
+	return out
+}
+
+func toCounts(counts map[string]int) map[string]int32 {
+	out := make(map[string]int32, len(counts))
+	for key, count := range counts {
+		out[key] = int32(count)
+	}
+	return out
+}
+
+func toFlagStats(stats map[string]*parser.FlagStats) map[string]*pb.FlagStats {
+	out := make(map[string]*pb.FlagStats, len(stats))
+	for key, s := range stats {
+		out[key] = &pb.FlagStats{
+			Pickups:      int32(s.Pickups),
+			Captures:     int32(s.Captures),
+			Returns:      int32(s.Returns),
+			CarrierKills: int32(s.CarrierKills),
+		}
+	}
+	return out
+}
+
+func toEvent(event parser.Event) *pb.Event {
+	return &pb.Event{
+		Type:   event.Type,
+		Game:   event.Game,
+		Time:   int32(event.Time),
+		Player: event.Player,
+		Killer: event.Killer,
+		Victim: event.Victim,
+		Means:  event.Means,
+	}
+}
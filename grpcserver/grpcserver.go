@@ -0,0 +1,85 @@
+// Package grpcserver exposes the parser over the gRPC service defined in proto/qgames.proto.
+package grpcserver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"qgames/parser"
+	"qgames/pb"
+)
+
+// Server implements pb.QGamesServer on top of the parser.
+type Server struct {
+	pb.UnimplementedQGamesServer
+
+	// Metrics counts the activity of the parsers of the server, optional.
+	Metrics *parser.Metrics
+}
+
+// Register registers a new Server on the gRPC server.
+func Register(registrar grpc.ServiceRegistrar, metrics *parser.Metrics) *Server {
+	s := &Server{Metrics: metrics}
+	pb.RegisterQGamesServer(registrar, s)
+	return s
+}
+
+func (s *Server) Parse(ctx context.Context, req *pb.ParseRequest) (*pb.ParseResponse, error) {
+	p := parser.Parser{Metrics: s.Metrics}
+	games, err := p.ParseReader(bytes.NewReader(req.GetLog()))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &pb.ParseResponse{Games: make(map[string]*pb.Game, len(games))}
+	for key, game := range games {
+		resp.Games[key] = toGame(game)
+	}
+	return resp, nil
+}
+
+// StreamEvents sends the events matching the request filters as the log is parsed, line by line,
+// stopping the parsing at the first failed send or when the client goes away.
+func (s *Server) StreamEvents(req *pb.StreamEventsRequest, stream grpc.ServerStreamingServer[pb.Event]) error {
+	ctx := stream.Context()
+
+	var sendErr error
+	p := parser.Parser{Metrics: s.Metrics}
+	p.OnEvent = func(event parser.Event) {
+		if sendErr != nil {
+			return
+		}
+		if req.GetGame() != "" && event.Game != req.GetGame() {
+			return
+		}
+		if req.GetPlayer() != "" && !event.Involves(req.GetPlayer()) {
+			return
+		}
+		if err := ctx.Err(); err != nil {
+			sendErr = status.FromContextError(err).Err()
+			return
+		}
+		sendErr = stream.Send(toEvent(event))
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(req.GetLog()))
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		if sendErr != nil {
+			return sendErr
+		}
+		p.ParseLine(scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	p.End()
+	return sendErr
+}
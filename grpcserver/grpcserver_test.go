@@ -0,0 +1,166 @@
+//go:build unit
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"qgames/parser"
+	"qgames/pb"
+)
+
+const testLog = `  0:00 InitGame: \sv_floodProtect\1\g_gametype\0\mapname\q3dm17
+ 20:34 ClientConnect: 2
+ 20:34 ClientUserinfoChanged: 2 n\Isgalamido\t\0\model\xian/default
+ 20:35 ClientConnect: 3
+ 20:35 ClientUserinfoChanged: 3 n\Zeh\t\0\model\sarge
+ 20:54 Kill: 2 3 6: Isgalamido killed Zeh by MOD_ROCKET
+ 20:55 ShutdownGame:
+  0:00 InitGame: \sv_floodProtect\1\g_gametype\0\mapname\q3dm17
+  0:10 ClientConnect: 2
+  0:10 ClientUserinfoChanged: 2 n\Mal\t\0\model\sarge
+  0:20 Kill: 1022 2 22: <world> killed Mal by
+  0:30 ShutdownGame:
+`
+
+// newTestClient serves a Server over an in-process bufconn listener.
+func newTestClient(t *testing.T) pb.QGamesClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	Register(srv, parser.NewMetrics())
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewQGamesClient(conn)
+}
+
+func TestServer_Parse(t *testing.T) {
+	client := newTestClient(t)
+
+	resp, err := client.Parse(context.Background(), &pb.ParseRequest{Log: []byte(testLog)})
+	require.NoError(t, err)
+	require.Len(t, resp.GetGames(), 2)
+
+	game := resp.GetGames()["game_01"]
+	assert.Equal(t, "q3dm17", game.GetMap())
+	assert.Equal(t, int32(1), game.GetTotalKills())
+	assert.ElementsMatch(t, []string{"Isgalamido", "Zeh"}, game.GetPlayers())
+	assert.Equal(t, map[string]int32{"Isgalamido": 1}, game.GetKills())
+	assert.Equal(t, map[string]int32{"MOD_ROCKET": 1}, game.GetKillsByMeans())
+	assert.Equal(t, int32(1234), game.GetConnections()["Isgalamido"].GetJoinedAt())
+	assert.True(t, game.GetConnections()["Zeh"].GetPresentAtEnd())
+	assert.Equal(t, "Isgalamido", game.GetAwards().GetFirstBlood())
+	assert.Equal(t, map[string]int32{"Isgalamido": 1}, game.GetAwards().GetStreaks())
+	assert.Equal(t, map[string]int32{"Zeh": 1}, game.GetKillMatrix()["Isgalamido"].GetCounts())
+	assert.Nil(t, game.GetTeams())
+	assert.Nil(t, game.GetCtf())
+
+	game = resp.GetGames()["game_02"]
+	assert.Equal(t, int32(1), game.GetTotalKills())
+	assert.Equal(t, map[string]int32{"Mal": -1}, game.GetKills())
+	assert.Equal(t, "<world> killed Mal by MOD_TRIGGER_HURT", game.GetInconsistencies()[0].GetResolved())
+}
+
+func TestServer_StreamEvents(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *pb.StreamEventsRequest
+		wantTypes []string
+	}{
+		{
+			name: "All",
+			req:  &pb.StreamEventsRequest{Log: []byte(testLog)},
+			wantTypes: []string{
+				parser.EventGameStart, parser.EventJoin, parser.EventJoin, parser.EventKill, parser.EventLeave, parser.EventLeave, parser.EventGameEnd,
+				parser.EventGameStart, parser.EventJoin, parser.EventKill, parser.EventLeave, parser.EventGameEnd,
+			},
+		},
+		{
+			name:      "Game",
+			req:       &pb.StreamEventsRequest{Log: []byte(testLog), Game: "game_02"},
+			wantTypes: []string{parser.EventGameStart, parser.EventJoin, parser.EventKill, parser.EventLeave, parser.EventGameEnd},
+		},
+		{
+			name:      "Player",
+			req:       &pb.StreamEventsRequest{Log: []byte(testLog), Player: "Zeh"},
+			wantTypes: []string{parser.EventJoin, parser.EventKill, parser.EventLeave},
+		},
+		{
+			name:      "Empty",
+			req:       &pb.StreamEventsRequest{},
+			wantTypes: nil,
+		},
+	}
+
+	client := newTestClient(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := client.StreamEvents(context.Background(), tt.req)
+			require.NoError(t, err)
+
+			var gotTypes []string
+			for {
+				event, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				require.NoError(t, err)
+				gotTypes = append(gotTypes, event.GetType())
+			}
+			assert.Equal(t, tt.wantTypes, gotTypes)
+		})
+	}
+}
+
+// cancelingStream is a StreamEvents stream whose client goes away after its first event.
+type cancelingStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	sent   int
+}
+
+func (s *cancelingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *cancelingStream) Send(*pb.Event) error {
+	s.sent++
+	s.cancel()
+	return nil
+}
+
+func TestServer_StreamEvents_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &cancelingStream{ctx: ctx, cancel: cancel}
+	s := &Server{Metrics: parser.NewMetrics()}
+
+	err := s.StreamEvents(&pb.StreamEventsRequest{Log: []byte(testLog)}, stream)
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Equal(t, 1, stream.sent)
+	// The InitGame line sent the first event, the line after it is never parsed.
+	assert.Equal(t, 1, s.Metrics.Snapshot().Lines)
+}
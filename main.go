@@ -1,30 +1,142 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"qgames/parser"
+	"qgames/store"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "serve":
+			serve(os.Args[2:])
+			return
+		case "query":
+			query(os.Args[2:])
+			return
+		case "gen":
+			gen(os.Args[2:])
+			return
+		}
+	}
+
 	// Define flags
 	var inFile string
 	var outFile string
+	var chat bool
+	var dbFile string
+	var stateFile string
+	var matrixFile string
+	var matrixDir string
+	var ratingsFile string
+	var seedFile string
+	var byFamily bool
+	var keepColors bool
+	var aliasesFile string
+	var unmappedFile string
+	var scoringFile string
+	var allPlayers bool
+	var dialectName string
 
 	// Parse command-line arguments
 	flag.StringVar(&inFile, "in", "qgames.log", "Input file name")
 	flag.StringVar(&outFile, "out", "qgames.json", "Output file name")
+	flag.BoolVar(&chat, "chat", false, "Only print the chat messages of every game")
+	flag.StringVar(&dbFile, "db", "", "SQLite database file to store the parsed games in, optional")
+	flag.StringVar(&stateFile, "state", "", "State file to resume parsing from, only writing the games new or updated since, optional")
+	flag.StringVar(&matrixFile, "matrix", "", "CSV file to write the kill matrix across every game to, optional")
+	flag.StringVar(&matrixDir, "matrices", "", "Directory to write the kill matrix of each game to, as a CSV file per game, e.g. game_01.csv, optional")
+	flag.StringVar(&ratingsFile, "ratings", "", "JSON file to write the Elo ratings of the players, with their history, to, optional")
+	flag.StringVar(&seedFile, "ratings-seed", "", "JSON file of previous ratings, as written by -ratings, to start from, optional")
+	flag.StringVar(&aliasesFile, "aliases", "", "Alias config, in YAML or JSON, naming the players by their canonical players, optional")
+	flag.StringVar(&unmappedFile, "unmapped", "", "Output file of the player names the alias config does not map, optional")
+	flag.StringVar(&scoringFile, "scoring", "", "Scoring rules of the kills, in YAML or JSON, optional")
+	flag.StringVar(&dialectName, "dialect", "", "Log dialect of every game, baseq3, openarena or urbanterror, rather than the one detected from its InitGame line, optional")
+	flag.BoolVar(&allPlayers, "all-players", false, "List every player in kills and the per-player stats, with explicit zeros")
+	flag.BoolVar(&keepColors, "keep-colors", false, "Name the players with their color codes, e.g. ^1Red^7Name rather than RedName")
+	flag.BoolVar(&byFamily, "by-family", false, "Also count the kills of every game by weapon family, e.g. rocket_launcher for MOD_ROCKET and MOD_ROCKET_SPLASH")
 	flag.Parse()
 
-	p := parser.Parser{}
-	parsedLog, err := p.Parse(inFile)
+	p := parser.Parser{MeansByFamily: byFamily, KeepColors: keepColors, KeepAllPlayers: allPlayers}
+	if aliasesFile != "" {
+		aliases, err := parser.LoadAliases(aliasesFile)
+		if err != nil {
+			panic(err)
+		}
+		p.Aliases = aliases
+	}
+	if dialectName != "" {
+		dialect, ok := parser.DialectByName(dialectName)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "unknown dialect %q\n", dialectName)
+			os.Exit(2)
+		}
+		p.Dialect = dialect
+	}
+	if scoringFile != "" {
+		rules, err := parser.LoadScoringRules(scoringFile)
+		if err != nil {
+			panic(err)
+		}
+		p.Scoring = &rules
+	}
+
+	if dbFile != "" {
+		if err := importGames(dbFile, inFile, &p); err != nil {
+			panic(err)
+		}
+	}
+
+	var games map[string]parser.Game
+	var err error
+	if stateFile != "" {
+		games, err = p.ParseIncremental(inFile, stateFile)
+	} else {
+		games, err = p.ParseGames(inFile)
+	}
 	if err != nil {
 		panic(err)
 	}
 
+	if matrixFile != "" {
+		if err := writeMatrixToFile(matrixFile, parser.OverallKillMatrix(games)); err != nil {
+			panic(err)
+		}
+	}
+
+	if matrixDir != "" {
+		if err := writeGameMatrices(matrixDir, games); err != nil {
+			panic(err)
+		}
+	}
+
+	if ratingsFile != "" {
+		if err := writeRatingsToFile(ratingsFile, seedFile, games); err != nil {
+			panic(err)
+		}
+	}
+
+	if unmappedFile != "" {
+		out, _ := json.Marshal(p.Aliases.Unmapped(games))
+		if err := writeOutputToFile(unmappedFile, string(out)); err != nil {
+			panic(err)
+		}
+	}
+
+	if chat {
+		printChat(os.Stdout, games)
+		return
+	}
+
+	out, _ := json.Marshal(games)
+	parsedLog := string(out)
 	if err := writeOutputToFile(outFile, parsedLog); err != nil {
 		fmt.Println(parsedLog)
 		panic(err)
@@ -45,3 +157,86 @@ func writeOutputToFile(outFile, parsedLog string) error {
 
 	return nil
 }
+
+func writeMatrixToFile(matrixFile string, matrix parser.KillMatrix) error {
+	f, err := os.Create(matrixFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return matrix.WriteCSV(f)
+}
+
+// writeGameMatrices writes the kill matrix of each game to a CSV file of the directory, named
+// after the game.
+func writeGameMatrices(matrixDir string, games map[string]parser.Game) error {
+	if err := os.MkdirAll(matrixDir, 0o755); err != nil {
+		return err
+	}
+
+	for _, key := range parser.GameKeys(games) {
+		if err := writeMatrixToFile(filepath.Join(matrixDir, key+".csv"), games[key].KillMatrix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeRatingsToFile rates the players over the games, starting from the ratings of the seed file when set.
+func writeRatingsToFile(ratingsFile, seedFile string, games map[string]parser.Game) error {
+	var seed map[string]float64
+	if seedFile != "" {
+		f, err := os.Open(seedFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		if seed, err = parser.ReadRatings(f); err != nil {
+			return err
+		}
+	}
+
+	out, _ := json.Marshal(parser.Ratings(games, seed))
+	return writeOutputToFile(ratingsFile, string(out))
+}
+
+// importGames stores the games of the input file, parsed as configured, in the database, skipping
+// those already stored.
+func importGames(dbFile, inFile string, p *parser.Parser) error {
+	s, err := store.Open(dbFile)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	f, err := os.Open(inFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	added, err := s.Import(f, p)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Stored %d new games in %s\n", added, dbFile)
+	return nil
+}
+
+func printChat(w io.Writer, games map[string]parser.Game) {
+	for _, key := range parser.GameKeys(games) {
+		for _, message := range games[key].Chat {
+			channel, speaker := "say", message.Player
+			if message.Team {
+				channel = "sayteam"
+			}
+			if message.To != "" {
+				channel, speaker = "tell", message.Player+" to "+message.To
+			}
+			fmt.Fprintf(w, "%s %3d:%02d %s %s: %s\n", key, message.Time/60, message.Time%60, channel, speaker, message.Message)
+		}
+	}
+}
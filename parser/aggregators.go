@@ -0,0 +1,266 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Aggregator computes a stat of every game from its events. The parser calls OnGameStart when a
+// game starts, OnEvent with every event of the game, then OnGameEnd when it ends, and puts the
+// Result of the game in its output, under the key the aggregator is registered with.
+type Aggregator interface {
+	OnGameStart(game string)
+	OnEvent(event Event)
+	OnGameEnd(game string)
+	Result() any
+}
+
+// killsAggregator is the built-in aggregator counting the kills of a game by means of death, and
+// by player as the scoring rules score them. The parser writes its result into the TotalKills,
+// Kills, KillsByMeans and KillsByFamily of the game as the game goes.
+type killsAggregator struct {
+	rules    ScoringRules
+	keepAll  bool
+	byFamily bool
+	// teamKill reports whether the killer and the victim play in the same team.
+	teamKill func(killer, victim string) bool
+
+	game   string
+	counts killCounts
+}
+
+// killCounts is the result of the killsAggregator.
+type killCounts struct {
+	TotalKills    int
+	Kills         map[string]int
+	KillsByMeans  map[string]int
+	KillsByFamily map[string]int
+}
+
+func (a *killsAggregator) OnGameStart(game string) {
+	a.game = game
+	a.counts = killCounts{Kills: make(map[string]int), KillsByMeans: make(map[string]int)}
+	if a.byFamily {
+		a.counts.KillsByFamily = make(map[string]int)
+	}
+}
+
+// resume goes on counting the kills of a game from those it counted so far, as when the game
+// is resumed from a checkpoint.
+func (a *killsAggregator) resume(game string, counted Game) {
+	a.game = game
+	a.counts = killCounts{
+		TotalKills:   counted.TotalKills,
+		Kills:        copyCounts(counted.Kills),
+		KillsByMeans: copyCounts(counted.KillsByMeans),
+	}
+	if counted.KillsByFamily != nil {
+		a.counts.KillsByFamily = copyCounts(counted.KillsByFamily)
+	}
+}
+
+func (a *killsAggregator) OnEvent(event Event) {
+	if event.Type != EventKill {
+		return
+	}
+
+	a.counts.TotalKills++
+	a.counts.KillsByMeans[event.Means]++
+	if a.counts.KillsByFamily != nil {
+		a.counts.KillsByFamily[MeansFamily(event.Means)]++
+	}
+
+	switch {
+	case event.Killer == event.Victim:
+		a.score(event.Victim, a.rules.Suicide)
+	case a.teamKill(event.Killer, event.Victim):
+		a.score(event.Killer, a.rules.TeamKill)
+	case event.Killer != "<world>":
+		a.score(event.Killer, a.rules.Kill)
+	default:
+		a.score(event.Victim, a.rules.WorldDeath)
+	}
+}
+
+// score adds the points to the player in Kills, dropping the players back to zero unless the
+// zeros are kept.
+func (a *killsAggregator) score(player string, points int) {
+	a.counts.Kills[player] += points
+	if a.counts.Kills[player] == 0 && !a.rules.KeepZero && !a.keepAll {
+		delete(a.counts.Kills, player)
+	}
+}
+
+// keep lists the player in Kills, with a zero score if the player has none.
+func (a *killsAggregator) keep(player string) {
+	if _, ok := a.counts.Kills[player]; !ok {
+		a.counts.Kills[player] = 0
+	}
+}
+
+func (*killsAggregator) OnGameEnd(string) {}
+
+func (a *killsAggregator) Result() any {
+	return a.counts
+}
+
+func copyCounts(counts map[string]int) map[string]int {
+	out := make(map[string]int, len(counts))
+	for key, count := range counts {
+		out[key] = count
+	}
+	return out
+}
+
+// gameFields are the JSON names of the fields of a game, which no aggregator can be registered
+// under.
+var gameFields = jsonFields(reflect.TypeOf(Game{}))
+
+func jsonFields(t reflect.Type) map[string]bool {
+	fields := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = true
+		}
+	}
+	return fields
+}
+
+// aggregatorList returns the built-in aggregators, then the registered ones, by key. It is built
+// once per parse, leaving out the aggregators registered under the key of a game field, with an
+// error.
+func (p *Parser) aggregatorList() ([]Aggregator, error) {
+	if p.aggregators != nil {
+		return p.aggregators, p.aggregatorErr
+	}
+
+	p.kills = &killsAggregator{
+		rules:    p.scoringRules(),
+		keepAll:  p.KeepAllPlayers,
+		byFamily: p.MeansByFamily,
+		teamKill: p.teamKill,
+	}
+	keys := make([]string, 0, len(p.Aggregators))
+	for key := range p.Aggregators {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	p.aggregators = []Aggregator{p.kills}
+	p.aggregatorKeys = make([]string, 0, len(keys))
+	p.aggregatorErr = nil
+	for _, key := range keys {
+		if gameFields[key] {
+			if p.aggregatorErr == nil {
+				p.aggregatorErr = fmt.Errorf("invalid aggregator key %q: already a field of the games", key)
+			}
+			continue
+		}
+		p.aggregatorKeys = append(p.aggregatorKeys, key)
+		p.aggregators = append(p.aggregators, p.Aggregators[key])
+	}
+	return p.aggregators, p.aggregatorErr
+}
+
+// CheckAggregators reports an aggregator registered under the key of a game field, whose result
+// could not be added to the games. ParseReader, ParseGames and ParseIncremental fail with it;
+// callers feeding the parser with ParseLine check it first, as such aggregators are left out.
+func (p *Parser) CheckAggregators() error {
+	_, err := p.aggregatorList()
+	return err
+}
+
+// gameKills returns the built-in kills aggregator of the game, resumed from the kills the game
+// counted so far when the aggregator did not see it start.
+func (p *Parser) gameKills(key string) *killsAggregator {
+	p.aggregatorList()
+	if p.kills.game != key {
+		p.kills.resume(key, p.log[key])
+	}
+	return p.kills
+}
+
+// writeKills writes the result of the built-in kills aggregator into the game.
+func (p *Parser) writeKills(key string) {
+	game, ok := p.log[key]
+	if !ok {
+		return
+	}
+
+	counts := p.gameKills(key).Result().(killCounts)
+	game.TotalKills = counts.TotalKills
+	game.Kills = counts.Kills
+	game.KillsByMeans = counts.KillsByMeans
+	game.KillsByFamily = counts.KillsByFamily
+	p.log[key] = game
+}
+
+// aggregate sends the event to the built-in aggregators, then to the registered ones, by key.
+// It writes the kills into the game, and adds the results of the registered ones once it ends.
+func (p *Parser) aggregate(event Event) {
+	p.gameKills(event.Game)
+	for _, aggregator := range p.aggregators {
+		switch event.Type {
+		case EventGameStart:
+			aggregator.OnGameStart(event.Game)
+		case EventGameEnd:
+			aggregator.OnGameEnd(event.Game)
+		default:
+			aggregator.OnEvent(event)
+		}
+	}
+	p.writeKills(event.Game)
+
+	game, ok := p.log[event.Game]
+	if event.Type != EventGameEnd || !ok || len(p.aggregatorKeys) == 0 {
+		return
+	}
+	game.Aggregates = make(map[string]any, len(p.aggregatorKeys))
+	for _, key := range p.aggregatorKeys {
+		game.Aggregates[key] = p.Aggregators[key].Result()
+	}
+	p.log[event.Game] = game
+}
+
+// MarshalJSON adds the results of the registered aggregators to the fields of the game, under
+// their keys, but for the keys of the fields.
+func (g Game) MarshalJSON() ([]byte, error) {
+	type game Game
+	out, err := json.Marshal(game(g))
+	if err != nil || len(g.Aggregates) == 0 {
+		return out, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(out, &fields); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(g.Aggregates))
+	for key := range g.Aggregates {
+		if _, ok := fields[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.Write(out[:len(out)-1])
+	for _, key := range keys {
+		name, _ := json.Marshal(key)
+		value, err := json.Marshal(g.Aggregates[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteByte(',')
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
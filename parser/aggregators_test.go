@@ -0,0 +1,371 @@
+//go:build unit
+
+package parser
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// railKills counts the railgun kills of every player, as a user aggregator would.
+type railKills struct {
+	games  []string
+	events []string
+	kills  map[string]int
+}
+
+func (a *railKills) OnGameStart(game string) {
+	a.games = append(a.games, "start "+game)
+	a.kills = make(map[string]int)
+}
+
+func (a *railKills) OnEvent(event Event) {
+	a.events = append(a.events, event.Type)
+	if event.Type == EventKill && event.Means == "MOD_RAILGUN" {
+		a.kills[event.Killer]++
+	}
+}
+
+func (a *railKills) OnGameEnd(game string) {
+	a.games = append(a.games, "end "+game)
+}
+
+func (a *railKills) Result() any {
+	return a.kills
+}
+
+// constant always results in the same value.
+type constant struct {
+	value any
+}
+
+func (constant) OnGameStart(string) {}
+func (constant) OnEvent(Event)      {}
+func (constant) OnGameEnd(string)   {}
+func (c constant) Result() any      { return c.value }
+
+func TestParser_Aggregators(t *testing.T) {
+	log := strings.Join([]string{
+		`  0:00 InitGame: \sv_hostname\Code Miner Server\g_gametype\0\mapname\q3dm17\gamename\baseq3`,
+		`  0:01 ClientUserinfoChanged: 2 n\Isgalamido\t\0\model\sarge`,
+		`  0:01 ClientBegin: 2`,
+		`  0:01 ClientUserinfoChanged: 3 n\Zeh\t\0\model\sarge`,
+		`  0:01 ClientBegin: 3`,
+		`  0:02 Kill: 2 3 10: Isgalamido killed Zeh by MOD_RAILGUN`,
+		`  0:03 Kill: 3 2 6: Zeh killed Isgalamido by MOD_ROCKET`,
+		`  0:04 Kill: 2 3 10: Isgalamido killed Zeh by MOD_RAILGUN`,
+		`  0:05 ShutdownGame:`,
+		`  0:00 InitGame: \sv_hostname\Code Miner Server\g_gametype\0\mapname\q3dm6\gamename\baseq3`,
+		`  0:01 ClientUserinfoChanged: 3 n\Zeh\t\0\model\sarge`,
+		`  0:02 Kill: 1022 3 22: <world> killed Zeh by MOD_TRIGGER_HURT`,
+	}, "\n")
+
+	rail := &railKills{}
+	p := Parser{Aggregators: map[string]Aggregator{
+		"rail_kills": rail,
+		"league":     constant{value: "Code Miner"},
+	}}
+	games, err := p.ParseReader(strings.NewReader(log))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"start game_01", "end game_01", "start game_02", "end game_02"}, rail.games)
+	assert.Equal(t, []string{"join", "join", "kill", "kill", "kill", "leave", "leave", "kill"}, rail.events)
+
+	assert.Equal(t, 3, games["game_01"].TotalKills)
+	assert.Equal(t, map[string]int{"Isgalamido": 2, "Zeh": 1}, games["game_01"].Kills)
+	assert.Equal(t, map[string]int{"MOD_RAILGUN": 2, "MOD_ROCKET": 1}, games["game_01"].KillsByMeans)
+	assert.Equal(t, map[string]any{
+		"rail_kills": map[string]int{"Isgalamido": 2},
+		"league":     "Code Miner",
+	}, games["game_01"].Aggregates)
+	assert.Equal(t, map[string]int{}, games["game_02"].Aggregates["rail_kills"])
+
+	out, err := json.Marshal(games["game_01"])
+	assert.NoError(t, err)
+	var fields map[string]any
+	assert.NoError(t, json.Unmarshal(out, &fields))
+	assert.Equal(t, map[string]any{"Isgalamido": float64(2), "Zeh": float64(1)}, fields["kills"])
+	assert.Equal(t, map[string]any{"Isgalamido": float64(2)}, fields["rail_kills"])
+	assert.Equal(t, "Code Miner", fields["league"])
+	assert.True(t, strings.HasSuffix(string(out), `"league":"Code Miner","rail_kills":{"Isgalamido":2}}`))
+}
+
+func TestParser_Aggregators_gameField(t *testing.T) {
+	log := strings.Join([]string{
+		`  0:00 InitGame: \sv_hostname\Code Miner Server\g_gametype\0\mapname\q3dm17\gamename\baseq3`,
+		`  0:01 ClientUserinfoChanged: 2 n\Isgalamido\t\0\model\sarge`,
+		`  0:02 Kill: 1022 2 22: <world> killed Isgalamido by MOD_TRIGGER_HURT`,
+		`  0:05 ShutdownGame:`,
+	}, "\n")
+	aggregators := map[string]Aggregator{
+		"kills":  constant{value: "a field of the games"},
+		"league": constant{value: "Code Miner"},
+	}
+
+	p := Parser{Aggregators: aggregators}
+	games, err := p.ParseReader(strings.NewReader(log))
+	assert.EqualError(t, err, `invalid aggregator key "kills": already a field of the games`)
+	assert.Nil(t, games)
+
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "games.log")
+	assert.NoError(t, os.WriteFile(logFile, []byte(log), 0o644))
+	_, err = (&Parser{Aggregators: aggregators}).ParseGames(logFile)
+	assert.Error(t, err)
+	_, err = (&Parser{Aggregators: aggregators}).ParseIncremental(logFile, filepath.Join(dir, "state.json"))
+	assert.Error(t, err)
+
+	p = Parser{Aggregators: aggregators}
+	assert.Error(t, p.CheckAggregators())
+	for _, line := range strings.Split(log, "\n") {
+		p.ParseLine(line)
+	}
+	assert.Equal(t, map[string]int{"Isgalamido": -1}, p.Games()["game_01"].Kills)
+	assert.Equal(t, map[string]any{"league": "Code Miner"}, p.Games()["game_01"].Aggregates)
+}
+
+func TestGame_MarshalJSON(t *testing.T) {
+	game := Game{Map: "q3dm17", Players: []string{}}
+	withAggregates := game
+	withAggregates.Aggregates = map[string]any{"map": "ignored", "first": 1}
+
+	plain, err := json.Marshal(game)
+	assert.NoError(t, err)
+	merged, err := json.Marshal(withAggregates)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.TrimSuffix(string(plain), "}")+`,"first":1}`, string(merged))
+}
+
+func TestKillsAggregator_OnEvent(t *testing.T) {
+	kill := func(killer, victim, means string) Event {
+		return Event{Type: EventKill, Killer: killer, Victim: victim, Means: means}
+	}
+	tests := []struct {
+		name      string
+		rules     *ScoringRules
+		keepAll   bool
+		teammates bool
+		counted   killCounts
+		event     Event
+		want      killCounts
+	}{
+		{
+			name:    "Success",
+			counted: killCounts{Kills: make(map[string]int), KillsByMeans: make(map[string]int)},
+			event:   kill("Isgalamido", "Zeh", "MOD_ROCKET"),
+			want: killCounts{
+				TotalKills:   1,
+				Kills:        map[string]int{"Isgalamido": 1},
+				KillsByMeans: map[string]int{"MOD_ROCKET": 1},
+			},
+		},
+		{
+			name: "Success with same weapon and a second hit from the same player",
+			counted: killCounts{
+				TotalKills:   1,
+				Kills:        map[string]int{"Isgalamido": 1},
+				KillsByMeans: map[string]int{"MOD_ROCKET": 1},
+			},
+			event: kill("Isgalamido", "Zeh", "MOD_ROCKET"),
+			want: killCounts{
+				TotalKills:   2,
+				Kills:        map[string]int{"Isgalamido": 2},
+				KillsByMeans: map[string]int{"MOD_ROCKET": 2},
+			},
+		},
+		{
+			name: "Success with different weapon and a hit from a different player",
+			counted: killCounts{
+				TotalKills:   1,
+				Kills:        map[string]int{"Isgalamido": 1},
+				KillsByMeans: map[string]int{"MOD_ROCKET": 1},
+			},
+			event: kill("Zeh", "Isgalamido", "MOD_ROCKET_SPLASH"),
+			want: killCounts{
+				TotalKills:   2,
+				Kills:        map[string]int{"Isgalamido": 1, "Zeh": 1},
+				KillsByMeans: map[string]int{"MOD_ROCKET": 1, "MOD_ROCKET_SPLASH": 1},
+			},
+		},
+		{
+			name: "Success by family",
+			counted: killCounts{
+				TotalKills:    1,
+				Kills:         map[string]int{"Isgalamido": 1},
+				KillsByMeans:  map[string]int{"MOD_ROCKET": 1},
+				KillsByFamily: map[string]int{FamilyRocket: 1},
+			},
+			event: kill("Isgalamido", "Zeh", "MOD_ROCKET_SPLASH"),
+			want: killCounts{
+				TotalKills:    2,
+				Kills:         map[string]int{"Isgalamido": 2},
+				KillsByMeans:  map[string]int{"MOD_ROCKET": 1, "MOD_ROCKET_SPLASH": 1},
+				KillsByFamily: map[string]int{FamilyRocket: 2},
+			},
+		},
+		{
+			name: "Success with a hit from a player with -1 kills",
+			counted: killCounts{
+				TotalKills:   1,
+				Kills:        map[string]int{"Isgalamido": 1, "Zeh": -1},
+				KillsByMeans: map[string]int{"MOD_TRIGGER_HURT": 1},
+			},
+			event: kill("Zeh", "Isgalamido", "MOD_ROCKET"),
+			want: killCounts{
+				TotalKills:   2,
+				Kills:        map[string]int{"Isgalamido": 1},
+				KillsByMeans: map[string]int{"MOD_TRIGGER_HURT": 1, "MOD_ROCKET": 1},
+			},
+		},
+		{
+			name:    "World kill",
+			counted: killCounts{Kills: make(map[string]int), KillsByMeans: make(map[string]int)},
+			event:   kill("<world>", "Isgalamido", "MOD_TRIGGER_HURT"),
+			want: killCounts{
+				TotalKills:   1,
+				Kills:        map[string]int{"Isgalamido": -1},
+				KillsByMeans: map[string]int{"MOD_TRIGGER_HURT": 1},
+			},
+		},
+		{
+			name: "World kill of the same player",
+			counted: killCounts{
+				TotalKills:   1,
+				Kills:        map[string]int{"Isgalamido": -1},
+				KillsByMeans: map[string]int{"MOD_TRIGGER_HURT": 1},
+			},
+			event: kill("<world>", "Isgalamido", "MOD_TRIGGER_HURT"),
+			want: killCounts{
+				TotalKills:   2,
+				Kills:        map[string]int{"Isgalamido": -2},
+				KillsByMeans: map[string]int{"MOD_TRIGGER_HURT": 2},
+			},
+		},
+		{
+			name: "World kill of a player with 1 kill",
+			counted: killCounts{
+				TotalKills:   1,
+				Kills:        map[string]int{"Assasinu Credi": 1, "Isgalamido": 2},
+				KillsByMeans: map[string]int{"MOD_ROCKET_SPLASH": 1},
+			},
+			event: kill("<world>", "Assasinu Credi", "MOD_TRIGGER_HURT"),
+			want: killCounts{
+				TotalKills:   2,
+				Kills:        map[string]int{"Isgalamido": 2},
+				KillsByMeans: map[string]int{"MOD_ROCKET_SPLASH": 1, "MOD_TRIGGER_HURT": 1},
+			},
+		},
+		{
+			name:    "Zero kills kept with every player",
+			keepAll: true,
+			counted: killCounts{
+				TotalKills:   1,
+				Kills:        map[string]int{"Assasinu Credi": 1},
+				KillsByMeans: map[string]int{"MOD_ROCKET_SPLASH": 1},
+			},
+			event: kill("<world>", "Assasinu Credi", "MOD_TRIGGER_HURT"),
+			want: killCounts{
+				TotalKills:   2,
+				Kills:        map[string]int{"Assasinu Credi": 0},
+				KillsByMeans: map[string]int{"MOD_ROCKET_SPLASH": 1, "MOD_TRIGGER_HURT": 1},
+			},
+		},
+		{
+			name:  "Zero kills kept by the rules",
+			rules: &ScoringRules{Kill: 1, WorldDeath: -1, KeepZero: true},
+			counted: killCounts{
+				TotalKills:   1,
+				Kills:        map[string]int{"Assasinu Credi": 1},
+				KillsByMeans: map[string]int{"MOD_ROCKET_SPLASH": 1},
+			},
+			event: kill("<world>", "Assasinu Credi", "MOD_TRIGGER_HURT"),
+			want: killCounts{
+				TotalKills:   2,
+				Kills:        map[string]int{"Assasinu Credi": 0},
+				KillsByMeans: map[string]int{"MOD_ROCKET_SPLASH": 1, "MOD_TRIGGER_HURT": 1},
+			},
+		},
+		{
+			name:      "Team kill",
+			rules:     &ScoringRules{Kill: 1, WorldDeath: -1, TeamKill: -1},
+			teammates: true,
+			counted:   killCounts{Kills: make(map[string]int), KillsByMeans: make(map[string]int)},
+			event:     kill("Isgalamido", "Zeh", "MOD_RAILGUN"),
+			want: killCounts{
+				TotalKills:   1,
+				Kills:        map[string]int{"Isgalamido": -1},
+				KillsByMeans: map[string]int{"MOD_RAILGUN": 1},
+			},
+		},
+		{
+			name:    "Suicide",
+			rules:   &ScoringRules{Kill: 1, WorldDeath: -1, Suicide: -1},
+			counted: killCounts{Kills: make(map[string]int), KillsByMeans: make(map[string]int)},
+			event:   kill("Isgalamido", "Isgalamido", "MOD_ROCKET_SPLASH"),
+			want: killCounts{
+				TotalKills:   1,
+				Kills:        map[string]int{"Isgalamido": -1},
+				KillsByMeans: map[string]int{"MOD_ROCKET_SPLASH": 1},
+			},
+		},
+		{
+			name:    "Not a kill",
+			counted: killCounts{Kills: make(map[string]int), KillsByMeans: make(map[string]int)},
+			event:   Event{Type: EventJoin, Player: "Isgalamido"},
+			want:    killCounts{Kills: make(map[string]int), KillsByMeans: make(map[string]int)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := DefaultScoringRules()
+			if tt.rules != nil {
+				rules = *tt.rules
+			}
+			a := &killsAggregator{
+				rules:    rules,
+				keepAll:  tt.keepAll,
+				teamKill: func(string, string) bool { return tt.teammates },
+				counts:   tt.counted,
+			}
+			a.OnEvent(tt.event)
+			assert.Equal(t, tt.want, a.Result())
+		})
+	}
+}
+
+func TestParser_aggregate_resumed(t *testing.T) {
+	p := Parser{
+		MeansByFamily: true,
+		gameCounter:   1,
+		log: map[string]Game{
+			"game_01": {
+				TotalKills:   1,
+				Kills:        map[string]int{"Isgalamido": 1},
+				KillsByMeans: map[string]int{"MOD_ROCKET": 1},
+			},
+		},
+	}
+
+	p.aggregate(Event{Type: EventKill, Game: "game_01", Killer: "Isgalamido", Victim: "Zeh", Means: "MOD_RAILGUN"})
+	assert.Equal(t, Game{
+		TotalKills:   2,
+		Kills:        map[string]int{"Isgalamido": 2},
+		KillsByMeans: map[string]int{"MOD_ROCKET": 1, "MOD_RAILGUN": 1},
+	}, p.log["game_01"], "a game resumed without KillsByFamily goes on without it")
+
+	p.aggregate(Event{Type: EventGameStart, Game: "game_02"})
+	p.log["game_02"] = Game{}
+	p.aggregate(Event{Type: EventKill, Game: "game_02", Killer: "<world>", Victim: "Zeh", Means: "MOD_FALLING"})
+	assert.Equal(t, Game{
+		TotalKills:    1,
+		Kills:         map[string]int{"Zeh": -1},
+		KillsByMeans:  map[string]int{"MOD_FALLING": 1},
+		KillsByFamily: map[string]int{MeansFamily("MOD_FALLING"): 1},
+	}, p.log["game_02"])
+	assert.Len(t, p.aggregators, 1)
+}
@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Aliases maps the names players use in game to their canonical players; every canonical player is
+// mapped to itself.
+type Aliases map[string]string
+
+// ReadAliases reads an alias config, in YAML or JSON, listing the names of every canonical player:
+//
+//	Chessus:
+//	  - Chessus!
+//	  - chessus
+func ReadAliases(r io.Reader) (Aliases, error) {
+	var config map[string][]string
+	if err := yaml.NewDecoder(r).Decode(&config); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("invalid alias config: %w", err)
+	}
+
+	aliases := make(Aliases)
+	add := func(name, player string) error {
+		if other, ok := aliases[name]; ok && other != player {
+			return fmt.Errorf("invalid alias config: %q is both %q and %q", name, other, player)
+		}
+		aliases[name] = player
+		return nil
+	}
+	for player, names := range config {
+		if err := add(player, player); err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if err := add(name, player); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return aliases, nil
+}
+
+// LoadAliases reads the alias config of the file.
+func LoadAliases(filename string) (Aliases, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadAliases(f)
+}
+
+// Player returns the canonical player of the name, by the name or its clean name.
+func (a Aliases) Player(name string) (string, bool) {
+	if player, ok := a[name]; ok {
+		return player, true
+	}
+	player, ok := a[CleanName(name)]
+	return player, ok
+}
+
+// Unmapped returns the players of the games the aliases do not know, sorted.
+func (a Aliases) Unmapped(games map[string]Game) []string {
+	seen := make(map[string]bool)
+	unmapped := make([]string, 0)
+	for _, game := range games {
+		for _, player := range game.Players {
+			if _, ok := a.Player(player); ok || seen[player] {
+				continue
+			}
+			seen[player] = true
+			unmapped = append(unmapped, player)
+		}
+	}
+	sort.Strings(unmapped)
+	return unmapped
+}
@@ -0,0 +1,89 @@
+//go:build unit
+
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAliases(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		want    Aliases
+		wantErr bool
+	}{
+		{
+			name:   "YAML",
+			config: "Chessus:\n  - Chessus!\n  - chessus\nZeh: []\n",
+			want:   Aliases{"Chessus": "Chessus", "Chessus!": "Chessus", "chessus": "Chessus", "Zeh": "Zeh"},
+		},
+		{
+			name:   "JSON",
+			config: `{"Chessus": ["Chessus!"]}`,
+			want:   Aliases{"Chessus": "Chessus", "Chessus!": "Chessus"},
+		},
+		{
+			name:   "Empty",
+			config: "",
+			want:   Aliases{},
+		},
+		{
+			name:    "Name of two players",
+			config:  `{"Chessus": ["Mal"], "Zeh": ["Mal"]}`,
+			wantErr: true,
+		},
+		{
+			name:    "Not a list of names",
+			config:  `{"Chessus": "Chessus!"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadAliases(strings.NewReader(tt.config))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAliases_Unmapped(t *testing.T) {
+	aliases := Aliases{"Chessus": "Chessus", "Chessus!": "Chessus"}
+	games := map[string]Game{
+		"game_01": {Players: []string{"Chessus", "Zeh", "^1Chessus!"}},
+		"game_02": {Players: []string{"Mal", "Zeh"}},
+	}
+	assert.Equal(t, []string{"Mal", "Zeh"}, aliases.Unmapped(games))
+	assert.Equal(t, []string{"Chessus", "Mal", "Zeh", "^1Chessus!"}, Aliases(nil).Unmapped(games))
+}
+
+func TestParser_ParseLine_aliases(t *testing.T) {
+	lines := []string{
+		`  0:00 InitGame: \sv_hostname\Code Miner Server\g_gametype\0\mapname\q3dm17`,
+		`  0:01 ClientUserinfoChanged: 2 n\^1Chessus!\t\0\model\sarge`,
+		`  0:02 ClientUserinfoChanged: 3 n\Zeh\t\0\model\sarge`,
+		`  0:03 Kill: 2 3 7: ^1Chessus! killed Zeh by MOD_ROCKET_SPLASH`,
+		`  0:04 ClientUserinfoChanged: 2 n\Chessus\t\0\model\sarge`,
+		`  0:05 Kill: 2 3 10: Chessus killed Zeh by MOD_RAILGUN`,
+	}
+
+	p := Parser{Aliases: Aliases{"Chessus": "Chessus", "Chessus!": "Chessus"}}
+	for _, line := range lines {
+		p.ParseLine(line)
+	}
+	p.End()
+
+	game := p.log["game_01"]
+	assert.Equal(t, []string{"Chessus", "Zeh"}, game.Players)
+	assert.Equal(t, map[string]int{"Chessus": 2}, game.Kills)
+	assert.Equal(t, KillMatrix{"Chessus": {"Zeh": 2}}, game.KillMatrix)
+	assert.Empty(t, game.Inconsistencies)
+}
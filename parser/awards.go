@@ -0,0 +1,132 @@
+package parser
+
+type (
+	// Awards holds the feats of the players of a game, computed from its kills in order.
+	Awards struct {
+		// FirstBlood is the first player to kill another player.
+		FirstBlood string `json:"first_blood"`
+		// Streaks is the longest streak of each player, in kills without dying.
+		Streaks map[string]int `json:"streaks"`
+		// MultiKills counts the kills of each player in quick succession.
+		MultiKills map[string]*MultiKills `json:"multi_kills"`
+		// Dominations is, for each killer and victim, the longest run of kills of the victim
+		// by the killer without being killed back, once it reaches dominationKills.
+		Dominations map[string]map[string]int `json:"dominations"`
+	}
+
+	// MultiKills counts the series of kills each within multiKillWindow of the previous one, by length.
+	MultiKills struct {
+		Double int `json:"double"`
+		Triple int `json:"triple"`
+		// Quad counts the series of four kills or more.
+		Quad int `json:"quad"`
+	}
+
+	// awardState is the running state of the awards of the game in progress.
+	awardState struct {
+		Streaks map[string]int            `json:"streaks"`
+		Series  map[string]killSeries     `json:"series"`
+		Runs    map[string]map[string]int `json:"runs"`
+	}
+
+	// killSeries is the last kill of a player and how many kills led to it in quick succession.
+	killSeries struct {
+		At     int `json:"at"`
+		Length int `json:"length"`
+	}
+)
+
+const (
+	// multiKillWindow is the most seconds between two kills of a multi kill.
+	multiKillWindow = 2
+	// dominationKills is how many kills of the same victim in a row make a domination.
+	dominationKills = 3
+)
+
+func newAwards() *Awards {
+	return &Awards{
+		Streaks:     make(map[string]int),
+		MultiKills:  make(map[string]*MultiKills),
+		Dominations: make(map[string]map[string]int),
+	}
+}
+
+func newAwardState() awardState {
+	return awardState{
+		Streaks: make(map[string]int),
+		Series:  make(map[string]killSeries),
+		Runs:    make(map[string]map[string]int),
+	}
+}
+
+// addAwards updates the awards with a kill. Dying ends the streak and the series of the victim,
+// suicides and deaths by the world count for nothing else.
+func (p *Parser) addAwards(killer, victim string) {
+	awards := p.log[p.gameKey()].Awards
+	if awards == nil {
+		return
+	}
+	if p.awards.Streaks == nil {
+		p.awards = newAwardState()
+	}
+
+	delete(p.awards.Streaks, victim)
+	delete(p.awards.Series, victim)
+	if killer == victim || killer == "<world>" {
+		return
+	}
+	delete(p.awards.Runs[victim], killer)
+
+	if awards.FirstBlood == "" {
+		awards.FirstBlood = killer
+	}
+
+	p.awards.Streaks[killer]++
+	if p.awards.Streaks[killer] > awards.Streaks[killer] {
+		awards.Streaks[killer] = p.awards.Streaks[killer]
+	}
+
+	now := p.timestamp()
+	series := p.awards.Series[killer]
+	if series.Length > 0 && now-series.At <= multiKillWindow {
+		series.Length++
+	} else {
+		series.Length = 1
+	}
+	series.At = now
+	p.awards.Series[killer] = series
+	awards.addMultiKill(killer, series.Length)
+
+	if p.awards.Runs[killer] == nil {
+		p.awards.Runs[killer] = make(map[string]int)
+	}
+	p.awards.Runs[killer][victim]++
+	if run := p.awards.Runs[killer][victim]; run >= dominationKills && run > awards.Dominations[killer][victim] {
+		if awards.Dominations[killer] == nil {
+			awards.Dominations[killer] = make(map[string]int)
+		}
+		awards.Dominations[killer][victim] = run
+	}
+}
+
+// addMultiKill counts a series of kills as it grows, a series only counting once at its longest.
+func (a *Awards) addMultiKill(player string, length int) {
+	if length < 2 {
+		return
+	}
+	if a.MultiKills[player] == nil {
+		a.MultiKills[player] = &MultiKills{}
+	}
+
+	multiKills := a.MultiKills[player]
+	switch length {
+	case 2:
+		multiKills.Double++
+	case 3:
+		multiKills.Double--
+		multiKills.Triple++
+	case 4:
+		multiKills.Triple--
+		multiKills.Quad++
+	}
+}
@@ -0,0 +1,115 @@
+//go:build unit
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParser_addAwards(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  *Awards
+	}{
+		{
+			name: "No kills",
+			want: newAwards(),
+		},
+		{
+			name: "First blood skips suicides and world kills",
+			lines: []string{
+				"  1:00 Kill: 2 2 7: Zeh killed Zeh by MOD_ROCKET_SPLASH",
+				"  1:10 Kill: 1022 3 22: <world> killed Mal by MOD_TRIGGER_HURT",
+				"  1:20 Kill: 3 2 10: Mal killed Zeh by MOD_RAILGUN",
+			},
+			want: &Awards{
+				FirstBlood:  "Mal",
+				Streaks:     map[string]int{"Mal": 1},
+				MultiKills:  map[string]*MultiKills{},
+				Dominations: map[string]map[string]int{},
+			},
+		},
+		{
+			name: "Streak ends when dying",
+			lines: []string{
+				"  1:00 Kill: 2 3 10: Zeh killed Mal by MOD_RAILGUN",
+				"  1:10 Kill: 2 4 10: Zeh killed Isgalamido by MOD_RAILGUN",
+				"  1:20 Kill: 1022 2 22: <world> killed Zeh by MOD_TRIGGER_HURT",
+				"  1:30 Kill: 2 3 10: Zeh killed Mal by MOD_RAILGUN",
+			},
+			want: &Awards{
+				FirstBlood:  "Zeh",
+				Streaks:     map[string]int{"Zeh": 2},
+				MultiKills:  map[string]*MultiKills{},
+				Dominations: map[string]map[string]int{},
+			},
+		},
+		{
+			name: "Multi kills count once at their longest",
+			lines: []string{
+				"  1:00 Kill: 2 3 6: Zeh killed Mal by MOD_ROCKET",
+				"  1:01 Kill: 2 4 6: Zeh killed Isgalamido by MOD_ROCKET",
+				"  1:03 Kill: 2 5 6: Zeh killed Dono da Bola by MOD_ROCKET",
+				"  1:30 Kill: 2 3 6: Zeh killed Mal by MOD_ROCKET",
+				"  1:31 Kill: 2 4 6: Zeh killed Isgalamido by MOD_ROCKET",
+				"  2:00 Kill: 2 3 6: Zeh killed Mal by MOD_ROCKET",
+				"  2:00 Kill: 2 4 6: Zeh killed Isgalamido by MOD_ROCKET",
+				"  2:01 Kill: 2 5 6: Zeh killed Dono da Bola by MOD_ROCKET",
+				"  2:02 Kill: 2 6 6: Zeh killed Chessus by MOD_ROCKET",
+				"  2:04 Kill: 2 3 6: Zeh killed Mal by MOD_ROCKET",
+			},
+			want: &Awards{
+				FirstBlood:  "Zeh",
+				Streaks:     map[string]int{"Zeh": 10},
+				MultiKills:  map[string]*MultiKills{"Zeh": {Double: 1, Triple: 1, Quad: 1}},
+				Dominations: map[string]map[string]int{"Zeh": {"Mal": 4, "Isgalamido": 3}},
+			},
+		},
+		{
+			name: "Domination ends when killed back",
+			lines: []string{
+				"  1:00 Kill: 2 3 10: Zeh killed Mal by MOD_RAILGUN",
+				"  1:10 Kill: 2 3 10: Zeh killed Mal by MOD_RAILGUN",
+				"  1:20 Kill: 3 2 10: Mal killed Zeh by MOD_RAILGUN",
+				"  1:30 Kill: 2 3 10: Zeh killed Mal by MOD_RAILGUN",
+				"  1:40 Kill: 2 3 10: Zeh killed Mal by MOD_RAILGUN",
+				"  1:50 Kill: 1022 3 22: <world> killed Mal by MOD_TRIGGER_HURT",
+				"  2:00 Kill: 2 3 10: Zeh killed Mal by MOD_RAILGUN",
+			},
+			want: &Awards{
+				FirstBlood:  "Zeh",
+				Streaks:     map[string]int{"Zeh": 3, "Mal": 1},
+				MultiKills:  map[string]*MultiKills{},
+				Dominations: map[string]map[string]int{"Zeh": {"Mal": 3}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Parser{}
+			p.ParseLine(`  0:00 InitGame: \sv_floodProtect\1\g_gametype\0\mapname\q3dm17`)
+			for _, line := range tt.lines {
+				p.ParseLine(line)
+			}
+			assert.Equal(t, tt.want, p.Games()["game_01"].Awards)
+		})
+	}
+}
+
+func TestParser_addAwardsWithoutAwards(t *testing.T) {
+	p := Parser{gameCounter: 1, log: map[string]Game{"game_01": {}}}
+	p.addAwards("Zeh", "Mal")
+	assert.Nil(t, p.log["game_01"].Awards)
+}
+
+func TestAwards_addMultiKill(t *testing.T) {
+	awards := newAwards()
+	for _, length := range []int{1, 2, 1, 2, 3, 1, 2, 3, 4, 5} {
+		awards.addMultiKill("Zeh", length)
+	}
+	assert.Equal(t, &MultiKills{Double: 1, Triple: 1, Quad: 1}, awards.MultiKills["Zeh"])
+	assert.NotContains(t, awards.MultiKills, "Mal")
+}
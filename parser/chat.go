@@ -0,0 +1,87 @@
+package parser
+
+import "strings"
+
+// Message is a chat line, at a time in seconds. Team is set for the messages only sent to the player's team and To for
+// the private ones, tells, to the player they were sent to.
+type Message struct {
+	Time    int    `json:"time"`
+	Player  string `json:"player"`
+	Message string `json:"message"`
+	Team    bool   `json:"team,omitempty"`
+	To      string `json:"to,omitempty"`
+}
+
+// chatSpeaker splits the text of a say line into its player, the player a tell is sent to, and its
+// message. Names may contain ": " and " to ", so the longest names of connected players starting the
+// text, of their clients when logged, win over the first splits.
+func (p *Parser) chatSpeaker(say Line) (player, to, message string) {
+	player, to, message = say.Name, say.To, say.Message
+	if say.To == "" {
+		if name, rest := p.clientPrefix(say.Text, ": ", say.ClientID); name != "" {
+			player, message = name, rest
+		}
+		return p.playerName(player), p.clients[say.ToClientID], message
+	}
+
+	if name, rest := p.clientPrefix(say.Text, " to ", say.ClientID); name != "" {
+		player = name
+		to, message, _ = strings.Cut(rest, ": ")
+		if name, rest := p.clientPrefix(rest, ": ", ""); name != "" {
+			to, message = name, rest
+		}
+	}
+	return p.playerName(player), p.playerName(to), message
+}
+
+// clientPrefix returns the longest name, as logged, of a connected player, of the client when set,
+// starting the text before the separator, and the text after the separator.
+func (p *Parser) clientPrefix(text, separator, clientID string) (string, string) {
+	longest := ""
+	for id, name := range p.clients {
+		logged := p.displayName(name)
+		if clientID != "" && id != clientID {
+			continue
+		}
+		if len(logged) > len(longest) && strings.HasPrefix(text, logged+separator) {
+			longest = logged
+		}
+	}
+	if longest == "" {
+		return "", ""
+	}
+	return longest, strings.TrimPrefix(text, longest+separator)
+}
+
+// addMessage runs before the other line handlers, as players can type anything, "Kill:" and
+// "InitGame:" included, so a say line is recognized even when the game is in an error state, only
+// not recorded.
+func (p *Parser) addMessage() bool {
+	if p.gameDialect().Recognize(p.line) != LineSay {
+		return false
+	}
+	if p.errorState {
+		return true
+	}
+
+	say, ok := p.gameDialect().Decode(LineSay, p.line)
+	if !ok {
+		p.errorState = true
+		return true
+	}
+	game, ok := p.log[p.gameKey()]
+	if !ok {
+		return true
+	}
+	player, to, message := p.chatSpeaker(say)
+
+	game.Chat = append(game.Chat, Message{
+		Time:    p.timestamp(),
+		Player:  player,
+		Message: message,
+		Team:    say.TeamChat,
+		To:      to,
+	})
+	p.log[p.gameKey()] = game
+	return true
+}
@@ -0,0 +1,222 @@
+//go:build unit
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParser_addMessage(t *testing.T) {
+	tests := []struct {
+		name        string
+		fields      Parser
+		want        Parser
+		expectedRes bool
+	}{
+		{
+			name:        "Not say line",
+			fields:      Parser{},
+			want:        Parser{},
+			expectedRes: false,
+		},
+		{
+			name: "Game in error state",
+			fields: Parser{
+				line:        "981:21 say: Oootsimo: team red",
+				errorState:  true,
+				gameCounter: 0,
+				log:         make(map[string]Game),
+			},
+			want: Parser{
+				line:        "981:21 say: Oootsimo: team red",
+				errorState:  true,
+				gameCounter: 0,
+				log:         make(map[string]Game),
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Error in regex",
+			fields: Parser{
+				line:        "981:21 say: Oootsimo",
+				errorState:  false,
+				gameCounter: 0,
+				log:         make(map[string]Game),
+			},
+			want: Parser{
+				line:        "981:21 say: Oootsimo",
+				errorState:  true,
+				gameCounter: 0,
+				log:         make(map[string]Game),
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Success",
+			fields: Parser{
+				line:        "981:21 say: Oootsimo: team red",
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						Chat: make([]Message, 0),
+					},
+				},
+			},
+			want: Parser{
+				line:        "981:21 say: Oootsimo: team red",
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						Chat: []Message{
+							{Time: 58881, Player: "Oootsimo", Message: "team red"},
+						},
+					},
+				},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Success with team message",
+			fields: Parser{
+				line:        "  2:12 sayteam: Zeh: Kill: the carrier",
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						Chat: []Message{
+							{Time: 58881, Player: "Oootsimo", Message: "team red"},
+						},
+					},
+				},
+			},
+			want: Parser{
+				line:        "  2:12 sayteam: Zeh: Kill: the carrier",
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						Chat: []Message{
+							{Time: 58881, Player: "Oootsimo", Message: "team red"},
+							{Time: 132, Player: "Zeh", Message: "Kill: the carrier", Team: true},
+						},
+					},
+				},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Success with tell",
+			fields: Parser{
+				line:        "  3:40 tell: Zeh to Isgalamido: Kill: 2 3 4: x",
+				gameCounter: 1,
+				log:         map[string]Game{"game_01": {}},
+			},
+			want: Parser{
+				line:        "  3:40 tell: Zeh to Isgalamido: Kill: 2 3 4: x",
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						Chat: []Message{
+							{Time: 220, Player: "Zeh", Message: "Kill: 2 3 4: x", To: "Isgalamido"},
+						},
+					},
+				},
+			},
+			expectedRes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.fields.addMessage()
+			assert.Equal(t, tt.expectedRes, res)
+			assert.Equal(t, tt.want.line, tt.fields.line)
+			assert.Equal(t, tt.want.errorState, tt.fields.errorState)
+			assert.Equal(t, tt.want.gameCounter, tt.fields.gameCounter)
+			assert.Equal(t, tt.want.log, tt.fields.log)
+		})
+	}
+}
+
+func TestParser_chatSpeaker(t *testing.T) {
+	tests := []struct {
+		name        string
+		clients     map[string]string
+		say         Line
+		wantPlayer  string
+		wantTo      string
+		wantMessage string
+	}{
+		{
+			name:        "Unknown player",
+			say:         Line{Text: "Zeh: hi: all", Name: "Zeh", Message: "hi: all"},
+			wantPlayer:  "Zeh",
+			wantMessage: "hi: all",
+		},
+		{
+			name:        "Connected player with a colon",
+			clients:     map[string]string{"2": "Dono: da bola"},
+			say:         Line{Text: "Dono: da bola: gg", Name: "Dono", Message: "da bola: gg"},
+			wantPlayer:  "Dono: da bola",
+			wantMessage: "gg",
+		},
+		{
+			name:        "Tell between connected players",
+			clients:     map[string]string{"2": "Zeh to", "3": "Mal: x"},
+			say:         Line{Text: "Zeh to to Mal: x: hi", Name: "Zeh", To: "to Mal", Message: "x: hi"},
+			wantPlayer:  "Zeh to",
+			wantTo:      "Mal: x",
+			wantMessage: "hi",
+		},
+		{
+			name:        "Tell of unknown players",
+			say:         Line{Text: "Zeh to Mal: hi", Name: "Zeh", To: "Mal", Message: "hi"},
+			wantPlayer:  "Zeh",
+			wantTo:      "Mal",
+			wantMessage: "hi",
+		},
+		{
+			name:        "Tell by client IDs",
+			clients:     map[string]string{"0": "Gost", "1": "Ricky"},
+			say:         Line{ClientID: "0", ToClientID: "1", Text: "Gost: hi", Name: "Gost", Message: "hi"},
+			wantPlayer:  "Gost",
+			wantTo:      "Ricky",
+			wantMessage: "hi",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Parser{clients: tt.clients}
+			player, to, message := p.chatSpeaker(tt.say)
+			assert.Equal(t, tt.wantPlayer, player)
+			assert.Equal(t, tt.wantTo, to)
+			assert.Equal(t, tt.wantMessage, message)
+		})
+	}
+}
+
+func TestParser_ParseLine_chatInErrorState(t *testing.T) {
+	lines := []string{
+		`  0:00 InitGame: \sv_hostname\Code Miner Server\g_gametype\0\mapname\q3dm17\gamename\baseq3`,
+		`  0:01 ClientUserinfoChanged: 2 `,
+		`  0:02 say: Zeh: InitGame: lol`,
+		`  0:03 tell: Zeh to Mal: Kill: 1022 2 22: nope`,
+		`  0:04 ShutdownGame:`,
+		`  0:00 InitGame: \sv_hostname\Code Miner Server\g_gametype\0\mapname\q3dm6\gamename\baseq3`,
+		`  0:01 tell: Zeh to Mal: Kill: 1022 2 22: nope`,
+		`  0:02 ShutdownGame:`,
+	}
+
+	p := Parser{}
+	for _, line := range lines {
+		p.ParseLine(line)
+	}
+	p.End()
+
+	games := p.Games()
+	assert.Len(t, games, 1)
+	if assert.Contains(t, games, "game_02") {
+		assert.Equal(t, "q3dm6", games["game_02"].Map)
+		assert.Equal(t, 0, games["game_02"].TotalKills)
+		assert.Equal(t, []Message{{Time: 1, Player: "Zeh", To: "Mal", Message: "Kill: 1022 2 22: nope"}}, games["game_02"].Chat)
+	}
+}
@@ -0,0 +1,227 @@
+package parser
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+type (
+	// checkpoint is the parser state after the last complete line of a log, saved to a state
+	// file so that the next run only parses the lines written since.
+	checkpoint struct {
+		Offset      int64                     `json:"offset"`
+		Fingerprint string                    `json:"fingerprint"`
+		Line        string                    `json:"line"`
+		ErrorState  bool                      `json:"error_state"`
+		GameCounter int                       `json:"game_counter"`
+		Clients     map[string]string         `json:"clients"`
+		Sessions    map[string]int            `json:"sessions"`
+		LastTime    int                       `json:"last_time"`
+		Flags       map[string]checkpointFlag `json:"flags"`
+		CTFEvents   bool                      `json:"ctf_events"`
+		GameOver    bool                      `json:"game_over"`
+		Awards      awardState                `json:"awards"`
+		Dialect     string                    `json:"dialect,omitempty"`
+		// Game is the game in progress, the only one needed to go on parsing.
+		Game *Game `json:"game,omitempty"`
+	}
+
+	checkpointFlag struct {
+		Carrier   string `json:"carrier"`
+		Dropped   bool   `json:"dropped"`
+		DroppedAt int    `json:"dropped_at"`
+	}
+)
+
+// fingerprintSize is how many bytes before the checkpoint offset are hashed to recognize the log
+// on the next run: once rotated or truncated, the log no longer has them at that offset.
+const fingerprintSize = 4096
+
+// ParseIncremental resumes parsing the log file from the checkpoint saved in stateFile, then saves
+// a new checkpoint. It returns only the games new or updated since the checkpoint, or every game
+// when there is no checkpoint yet or the log was rotated or truncated since.
+// A last line without its newline is left for the next run, as it may still be being written.
+func (p *Parser) ParseIncremental(filename, stateFile string) (map[string]Game, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	cp, err := loadCheckpoint(stateFile)
+	if err != nil {
+		return nil, err
+	}
+	if ok, err := cp.matches(file); err != nil {
+		return nil, err
+	} else if !ok {
+		cp = checkpoint{}
+	}
+	p.restore(cp)
+	if err := p.CheckAggregators(); err != nil {
+		return nil, err
+	}
+
+	resumed, _ := json.Marshal(cp.Game)
+
+	if _, err := file.Seek(cp.Offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	offset := cp.Offset
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadString('\n')
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		offset += int64(len(line))
+		p.parseLine(strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"))
+	}
+
+	next, err := p.checkpoint(file, offset)
+	if err != nil {
+		return nil, err
+	}
+	if err := next.save(stateFile); err != nil {
+		return nil, err
+	}
+
+	// The resumed game is only reported when the new lines changed it.
+	unchanged := false
+	if game, ok := p.log[cp.gameKey()]; ok && cp.Game != nil {
+		updated, _ := json.Marshal(game)
+		unchanged = string(updated) == string(resumed)
+	}
+	p.End()
+	if unchanged {
+		delete(p.log, cp.gameKey())
+	}
+
+	return p.log, nil
+}
+
+func loadCheckpoint(stateFile string) (checkpoint, error) {
+	var cp checkpoint
+
+	data, err := os.ReadFile(stateFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return cp, nil
+	}
+	if err != nil {
+		return cp, err
+	}
+
+	err = json.Unmarshal(data, &cp)
+	return cp, err
+}
+
+// save writes the checkpoint to a temporary file first, so an interrupted run keeps the previous one.
+func (cp checkpoint) save(stateFile string) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+
+	tmp := stateFile + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, stateFile)
+}
+
+// matches reports whether the file is still the log the checkpoint was taken from.
+func (cp checkpoint) matches(file *os.File) (bool, error) {
+	if cp.Offset == 0 {
+		return true, nil
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+	if info.Size() < cp.Offset {
+		return false, nil
+	}
+
+	fingerprint, err := fingerprint(file, cp.Offset)
+	return fingerprint == cp.Fingerprint, err
+}
+
+func (cp checkpoint) gameKey() string {
+	return (&Parser{gameCounter: cp.GameCounter}).gameKey()
+}
+
+// fingerprint hashes the bytes preceding the offset.
+func fingerprint(file *os.File, offset int64) (string, error) {
+	start := max(offset-fingerprintSize, 0)
+	buf := make([]byte, offset-start)
+	if _, err := file.ReadAt(buf, start); err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(buf)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// checkpoint takes a checkpoint of the parser state, the file having been parsed up to offset.
+func (p *Parser) checkpoint(file *os.File, offset int64) (checkpoint, error) {
+	cp := checkpoint{
+		Offset:      offset,
+		Line:        p.line,
+		ErrorState:  p.errorState,
+		GameCounter: p.gameCounter,
+		Clients:     p.clients,
+		Sessions:    p.sessions,
+		LastTime:    p.lastTime,
+		Flags:       make(map[string]checkpointFlag, len(p.flags)),
+		CTFEvents:   p.ctfEvents,
+		GameOver:    p.gameOver,
+		Awards:      p.awards,
+	}
+	if p.dialect != nil {
+		cp.Dialect = p.dialect.Name()
+	}
+	for flag, state := range p.flags {
+		cp.Flags[flag] = checkpointFlag{Carrier: state.carrier, Dropped: state.dropped, DroppedAt: state.droppedAt}
+	}
+	if game, ok := p.log[p.gameKey()]; ok {
+		cp.Game = &game
+	}
+
+	var err error
+	cp.Fingerprint, err = fingerprint(file, offset)
+	return cp, err
+}
+
+// restore sets the parser state back to the checkpoint, the game in progress being the only game.
+func (p *Parser) restore(cp checkpoint) {
+	p.line = cp.Line
+	p.errorState = cp.ErrorState
+	p.gameCounter = cp.GameCounter
+	p.clients = cp.Clients
+	p.sessions = cp.Sessions
+	p.lastTime = cp.LastTime
+	p.flags = make(map[string]flagState, len(cp.Flags))
+	for flag, state := range cp.Flags {
+		p.flags[flag] = flagState{carrier: state.Carrier, dropped: state.Dropped, droppedAt: state.DroppedAt}
+	}
+	p.ctfEvents = cp.CTFEvents
+	p.gameOver = cp.GameOver
+	p.awards = cp.Awards
+	p.dialect, _ = DialectByName(cp.Dialect)
+	p.aggregators = nil
+
+	p.log = make(map[string]Game)
+	if cp.Game != nil {
+		p.log[cp.gameKey()] = *cp.Game
+	}
+}
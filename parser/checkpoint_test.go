@@ -0,0 +1,102 @@
+//go:build unit
+
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_ParseIncremental(t *testing.T) {
+	content, err := os.ReadFile("./test/Parse_1.log")
+	require.NoError(t, err)
+
+	want, err := (&Parser{}).ParseGames("./test/Parse_1.log")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		offsets []int
+	}{
+		{name: "Whole log", offsets: []int{len(content)}},
+		{name: "Line by line chunks", offsets: []int{1000, 5000, 20000, 50000, 100000, len(content)}},
+		{name: "Partial lines", offsets: []int{1037, 4999, 20011, 77777, len(content) - 3, len(content)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			logFile := filepath.Join(dir, "games.log")
+			stateFile := filepath.Join(dir, "games.state")
+
+			got := make(map[string]Game)
+			for _, offset := range tt.offsets {
+				require.NoError(t, os.WriteFile(logFile, content[:offset], 0o644))
+
+				games, err := (&Parser{}).ParseIncremental(logFile, stateFile)
+				require.NoError(t, err)
+				for key, game := range games {
+					got[key] = game
+				}
+			}
+			assert.Equal(t, want, got)
+
+			games, err := (&Parser{}).ParseIncremental(logFile, stateFile)
+			assert.NoError(t, err)
+			assert.Empty(t, games)
+		})
+	}
+}
+
+func TestParser_ParseIncrementalUpdates(t *testing.T) {
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "games.log")
+	stateFile := filepath.Join(dir, "games.state")
+
+	lines := []string{
+		"  0:00 InitGame: \\sv_floodProtect\\1\\g_gametype\\0\\mapname\\q3dm17\n",
+		" 20:34 ClientConnect: 2\n",
+		" 20:34 ClientUserinfoChanged: 2 n\\Isgalamido\\t\\0\\model\\xian/default\n",
+		" 20:54 Kill: 1022 2 22: <world> killed Isgalamido by MOD_TRIGGER_HURT\n",
+		"  0:00 InitGame: \\sv_floodProtect\\1\\g_gametype\\0\\mapname\\q3dm17\n",
+	}
+	write := func(content string) {
+		require.NoError(t, os.WriteFile(logFile, []byte(content), 0o644))
+	}
+
+	write(lines[0] + lines[1] + lines[2])
+	games, err := (&Parser{}).ParseIncremental(logFile, stateFile)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"game_01"}, GameKeys(games))
+	assert.Equal(t, 0, games["game_01"].TotalKills)
+
+	write(lines[0] + lines[1] + lines[2] + lines[3] + lines[4])
+	games, err = (&Parser{}).ParseIncremental(logFile, stateFile)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"game_01", "game_02"}, GameKeys(games))
+	assert.Equal(t, 1, games["game_01"].TotalKills)
+	assert.Equal(t, &Connection{JoinedAt: 1234, LeftAt: 1254, TimeOnServer: 20, PresentAtEnd: true}, games["game_01"].Connections["Isgalamido"])
+
+	// A rotated log, as long as the previous one but with other lines, is parsed from the start.
+	write(lines[0] + lines[1] + " 20:34 ClientUserinfoChanged: 2 n\\Zeh\\t\\0\\model\\xian/default\n" + lines[3] + lines[4])
+	games, err = (&Parser{}).ParseIncremental(logFile, stateFile)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"game_01", "game_02"}, GameKeys(games))
+	assert.Equal(t, []string{"Zeh"}, games["game_01"].Players)
+
+	// A truncated log is parsed from the start.
+	write(lines[0])
+	games, err = (&Parser{}).ParseIncremental(logFile, stateFile)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"game_01"}, GameKeys(games))
+
+	_, err = (&Parser{}).ParseIncremental(filepath.Join(dir, "missing.log"), stateFile)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	require.NoError(t, os.WriteFile(stateFile, []byte("{"), 0o644))
+	_, err = (&Parser{}).ParseIncremental(logFile, stateFile)
+	assert.Error(t, err)
+}
@@ -0,0 +1,176 @@
+package parser
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	connectLine    = regexp.MustCompile(`ClientConnect: (\d+)`)
+	beginLine      = regexp.MustCompile(`ClientBegin: (\d+)`)
+	disconnectLine = regexp.MustCompile(`ClientDisconnect: (\d+)`)
+)
+
+// Connection is the presence of a player on the server during a game, times are in seconds of game time.
+type Connection struct {
+	JoinedAt     int  `json:"joined_at"`
+	LeftAt       int  `json:"left_at"`
+	TimeOnServer int  `json:"time_on_server"`
+	Reconnects   int  `json:"reconnects"`
+	PresentAtEnd bool `json:"present_at_end"`
+}
+
+func (p *Parser) connectClient() bool {
+	if p.errorState || !strings.Contains(p.line, "ClientConnect:") {
+		return false
+	}
+
+	matches := connectLine.FindStringSubmatch(p.line)
+	if len(matches) < 2 {
+		p.errorState = true
+		return true
+	}
+
+	p.leaveClient(matches[1], p.timestamp(), false)
+	if p.sessions == nil {
+		p.sessions = make(map[string]int)
+	}
+	p.sessions[matches[1]] = p.timestamp()
+	delete(p.clients, matches[1])
+	return true
+}
+
+// beginClient opens the session of clients whose connection was not logged, as in logs starting mid-game.
+func (p *Parser) beginClient() bool {
+	if p.errorState || !strings.Contains(p.line, "ClientBegin:") {
+		return false
+	}
+
+	matches := beginLine.FindStringSubmatch(p.line)
+	if len(matches) < 2 {
+		p.errorState = true
+		return true
+	}
+
+	if _, ok := p.sessions[matches[1]]; !ok {
+		if p.sessions == nil {
+			p.sessions = make(map[string]int)
+		}
+		p.sessions[matches[1]] = p.timestamp()
+		p.joinClient(matches[1], false)
+	}
+	return true
+}
+
+func (p *Parser) disconnectClient() bool {
+	if p.errorState || !strings.Contains(p.line, "ClientDisconnect:") {
+		return false
+	}
+
+	matches := disconnectLine.FindStringSubmatch(p.line)
+	if len(matches) < 2 {
+		p.errorState = true
+		return true
+	}
+
+	p.returnFlags(p.clients[matches[1]])
+	p.leaveClient(matches[1], p.timestamp(), false)
+	delete(p.clients, matches[1])
+	return true
+}
+
+func (p *Parser) shutdownGame() bool {
+	if p.errorState || !strings.Contains(p.line, "ShutdownGame:") {
+		return false
+	}
+
+	p.endGame(p.timestamp())
+	return true
+}
+
+// joinClient starts the connection of the player behind the client, once its name is known. A
+// session opened by a rename rejoins the player without counting a reconnect.
+func (p *Parser) joinClient(id string, renamed bool) {
+	start, ok := p.sessions[id]
+	player := p.clients[id]
+	connections := p.log[p.gameKey()].Connections
+	if !ok || player == "" || connections == nil {
+		return
+	}
+
+	connection, ok := connections[player]
+	if !ok {
+		connections[player] = &Connection{JoinedAt: start, PresentAtEnd: true}
+		p.emit(Event{Type: EventJoin, Player: player})
+		return
+	}
+	if !connection.PresentAtEnd {
+		if !renamed {
+			connection.Reconnects++
+		}
+		connection.PresentAtEnd = true
+		p.emit(Event{Type: EventJoin, Player: player})
+	}
+}
+
+// renameClient moves the session of a client to its new name, the old name leaving the server.
+// It reports whether the client was renamed.
+func (p *Parser) renameClient(id, player string) bool {
+	current, ok := p.clients[id]
+	if !ok || current == player {
+		return false
+	}
+
+	p.leaveClient(id, p.timestamp(), true)
+	p.sessions[id] = p.timestamp()
+	return true
+}
+
+// leaveClient closes the session of a client, keepSession leaving it in place for a rename.
+func (p *Parser) leaveClient(id string, at int, keepSession bool) {
+	start, ok := p.sessions[id]
+	if !ok {
+		return
+	}
+	if !keepSession {
+		delete(p.sessions, id)
+	}
+
+	connection, ok := p.log[p.gameKey()].Connections[p.clients[id]]
+	if !ok || !connection.PresentAtEnd {
+		return
+	}
+
+	connection.LeftAt = at
+	connection.TimeOnServer += max(at-start, 0)
+	connection.PresentAtEnd = false
+	p.emit(Event{Type: EventLeave, Time: at, Player: p.clients[id]})
+}
+
+// endGame closes the sessions still open when the game ends, in client order, keeping those
+// players present at the end.
+func (p *Parser) endGame(at int) {
+	ids := make([]string, 0, len(p.sessions))
+	for id := range p.sessions {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, _ := strconv.Atoi(ids[i])
+		b, _ := strconv.Atoi(ids[j])
+		return a < b
+	})
+	for _, id := range ids {
+		connection, ok := p.log[p.gameKey()].Connections[p.clients[id]]
+		p.leaveClient(id, at, false)
+		if ok {
+			connection.PresentAtEnd = true
+		}
+	}
+
+	if p.gameCounter > 0 && !p.gameOver {
+		p.gameOver = true
+		p.emit(Event{Type: EventGameEnd, Time: at})
+	}
+}
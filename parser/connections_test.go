@@ -0,0 +1,434 @@
+//go:build unit
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParser_connectClient(t *testing.T) {
+	tests := []struct {
+		name            string
+		fields          Parser
+		wantSessions    map[string]int
+		wantClients     map[string]string
+		wantConnections map[string]*Connection
+		wantError       bool
+		expectedRes     bool
+	}{
+		{
+			name:        "Not ClientConnect line",
+			fields:      Parser{},
+			expectedRes: false,
+		},
+		{
+			name:        "Error in regex",
+			fields:      Parser{line: " 20:34 ClientConnect: "},
+			wantError:   true,
+			expectedRes: true,
+		},
+		{
+			name: "New client",
+			fields: Parser{
+				line:        " 20:34 ClientConnect: 2",
+				gameCounter: 1,
+				log:         map[string]Game{"game_01": {Connections: map[string]*Connection{}}},
+			},
+			wantSessions:    map[string]int{"2": 1234},
+			wantConnections: map[string]*Connection{},
+			expectedRes:     true,
+		},
+		{
+			name: "Client connecting again",
+			fields: Parser{
+				line:        " 20:40 ClientConnect: 2",
+				gameCounter: 1,
+				clients:     map[string]string{"2": "Isgalamido"},
+				sessions:    map[string]int{"2": 1234},
+				log: map[string]Game{"game_01": {Connections: map[string]*Connection{
+					"Isgalamido": {JoinedAt: 1234, PresentAtEnd: true},
+				}}},
+			},
+			wantSessions: map[string]int{"2": 1240},
+			wantClients:  map[string]string{},
+			wantConnections: map[string]*Connection{
+				"Isgalamido": {JoinedAt: 1234, LeftAt: 1240, TimeOnServer: 6},
+			},
+			expectedRes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.fields.connectClient()
+			assert.Equal(t, tt.expectedRes, res)
+			assert.Equal(t, tt.wantError, tt.fields.errorState)
+			assert.Equal(t, tt.wantSessions, tt.fields.sessions)
+			assert.Equal(t, tt.wantClients, tt.fields.clients)
+			assert.Equal(t, tt.wantConnections, tt.fields.log["game_01"].Connections)
+		})
+	}
+}
+
+func TestParser_beginClient(t *testing.T) {
+	tests := []struct {
+		name            string
+		fields          Parser
+		wantSessions    map[string]int
+		wantConnections map[string]*Connection
+		wantError       bool
+		expectedRes     bool
+	}{
+		{
+			name:        "Not ClientBegin line",
+			fields:      Parser{},
+			expectedRes: false,
+		},
+		{
+			name:        "Error in regex",
+			fields:      Parser{line: " 20:37 ClientBegin: "},
+			wantError:   true,
+			expectedRes: true,
+		},
+		{
+			name: "Session already open",
+			fields: Parser{
+				line:        " 20:37 ClientBegin: 2",
+				gameCounter: 1,
+				clients:     map[string]string{"2": "Isgalamido"},
+				sessions:    map[string]int{"2": 1234},
+				log: map[string]Game{"game_01": {Connections: map[string]*Connection{
+					"Isgalamido": {JoinedAt: 1234, PresentAtEnd: true},
+				}}},
+			},
+			wantSessions: map[string]int{"2": 1234},
+			wantConnections: map[string]*Connection{
+				"Isgalamido": {JoinedAt: 1234, PresentAtEnd: true},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Connection not logged",
+			fields: Parser{
+				line:        " 20:37 ClientBegin: 2",
+				gameCounter: 1,
+				clients:     map[string]string{"2": "Isgalamido"},
+				log:         map[string]Game{"game_01": {Connections: map[string]*Connection{}}},
+			},
+			wantSessions: map[string]int{"2": 1237},
+			wantConnections: map[string]*Connection{
+				"Isgalamido": {JoinedAt: 1237, PresentAtEnd: true},
+			},
+			expectedRes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.fields.beginClient()
+			assert.Equal(t, tt.expectedRes, res)
+			assert.Equal(t, tt.wantError, tt.fields.errorState)
+			assert.Equal(t, tt.wantSessions, tt.fields.sessions)
+			assert.Equal(t, tt.wantConnections, tt.fields.log["game_01"].Connections)
+		})
+	}
+}
+
+func TestParser_disconnectClient(t *testing.T) {
+	tests := []struct {
+		name            string
+		fields          Parser
+		wantSessions    map[string]int
+		wantClients     map[string]string
+		wantFlags       map[string]flagState
+		wantConnections map[string]*Connection
+		wantError       bool
+		expectedRes     bool
+	}{
+		{
+			name:        "Not ClientDisconnect line",
+			fields:      Parser{},
+			expectedRes: false,
+		},
+		{
+			name:        "Error in regex",
+			fields:      Parser{line: " 21:10 ClientDisconnect: "},
+			wantError:   true,
+			expectedRes: true,
+		},
+		{
+			name: "Success",
+			fields: Parser{
+				line:        " 21:10 ClientDisconnect: 2",
+				gameCounter: 1,
+				clients:     map[string]string{"2": "Isgalamido", "3": "Zeh"},
+				sessions:    map[string]int{"2": 1238, "3": 1240},
+				flags:       map[string]flagState{TeamBlue: {carrier: "Isgalamido"}},
+				log: map[string]Game{"game_01": {Connections: map[string]*Connection{
+					"Isgalamido": {JoinedAt: 1238, PresentAtEnd: true},
+					"Zeh":        {JoinedAt: 1240, PresentAtEnd: true},
+				}}},
+			},
+			wantSessions: map[string]int{"3": 1240},
+			wantClients:  map[string]string{"3": "Zeh"},
+			wantFlags:    map[string]flagState{TeamBlue: {}},
+			wantConnections: map[string]*Connection{
+				"Isgalamido": {JoinedAt: 1238, LeftAt: 1270, TimeOnServer: 32},
+				"Zeh":        {JoinedAt: 1240, PresentAtEnd: true},
+			},
+			expectedRes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.fields.disconnectClient()
+			assert.Equal(t, tt.expectedRes, res)
+			assert.Equal(t, tt.wantError, tt.fields.errorState)
+			assert.Equal(t, tt.wantSessions, tt.fields.sessions)
+			assert.Equal(t, tt.wantClients, tt.fields.clients)
+			assert.Equal(t, tt.wantFlags, tt.fields.flags)
+			assert.Equal(t, tt.wantConnections, tt.fields.log["game_01"].Connections)
+		})
+	}
+}
+
+func TestParser_shutdownGame(t *testing.T) {
+	tests := []struct {
+		name            string
+		fields          Parser
+		wantConnections map[string]*Connection
+		expectedRes     bool
+	}{
+		{
+			name:        "Not ShutdownGame line",
+			fields:      Parser{},
+			expectedRes: false,
+		},
+		{
+			name: "Success",
+			fields: Parser{
+				line:        " 20:37 ShutdownGame:",
+				gameCounter: 1,
+				clients:     map[string]string{"2": "Isgalamido"},
+				sessions:    map[string]int{"2": 1234},
+				log: map[string]Game{"game_01": {Connections: map[string]*Connection{
+					"Isgalamido": {JoinedAt: 1234, PresentAtEnd: true},
+				}}},
+			},
+			wantConnections: map[string]*Connection{
+				"Isgalamido": {JoinedAt: 1234, LeftAt: 1237, TimeOnServer: 3, PresentAtEnd: true},
+			},
+			expectedRes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.fields.shutdownGame()
+			assert.Equal(t, tt.expectedRes, res)
+			assert.Empty(t, tt.fields.sessions)
+			assert.Equal(t, tt.wantConnections, tt.fields.log["game_01"].Connections)
+		})
+	}
+}
+
+func TestParser_joinClient(t *testing.T) {
+	tests := []struct {
+		name            string
+		id              string
+		renamed         bool
+		fields          Parser
+		wantConnections map[string]*Connection
+	}{
+		{
+			name:            "Name not known yet",
+			id:              "2",
+			fields:          Parser{gameCounter: 1, sessions: map[string]int{"2": 1234}, log: map[string]Game{"game_01": {Connections: map[string]*Connection{}}}},
+			wantConnections: map[string]*Connection{},
+		},
+		{
+			name: "First connection",
+			id:   "2",
+			fields: Parser{
+				gameCounter: 1,
+				clients:     map[string]string{"2": "Isgalamido"},
+				sessions:    map[string]int{"2": 1234},
+				log:         map[string]Game{"game_01": {Connections: map[string]*Connection{}}},
+			},
+			wantConnections: map[string]*Connection{
+				"Isgalamido": {JoinedAt: 1234, PresentAtEnd: true},
+			},
+		},
+		{
+			name: "Reconnection",
+			id:   "2",
+			fields: Parser{
+				gameCounter: 1,
+				clients:     map[string]string{"2": "Isgalamido"},
+				sessions:    map[string]int{"2": 1275},
+				log: map[string]Game{"game_01": {Connections: map[string]*Connection{
+					"Isgalamido": {JoinedAt: 1238, LeftAt: 1270, TimeOnServer: 32},
+				}}},
+			},
+			wantConnections: map[string]*Connection{
+				"Isgalamido": {JoinedAt: 1238, LeftAt: 1270, TimeOnServer: 32, Reconnects: 1, PresentAtEnd: true},
+			},
+		},
+		{
+			name:    "Rename back to an earlier name",
+			id:      "2",
+			renamed: true,
+			fields: Parser{
+				gameCounter: 1,
+				clients:     map[string]string{"2": "Dono da Bola"},
+				sessions:    map[string]int{"2": 86},
+				log: map[string]Game{"game_01": {Connections: map[string]*Connection{
+					"Dono da Bola": {JoinedAt: 25, LeftAt: 27, TimeOnServer: 2},
+				}}},
+			},
+			wantConnections: map[string]*Connection{
+				"Dono da Bola": {JoinedAt: 25, LeftAt: 27, TimeOnServer: 2, PresentAtEnd: true},
+			},
+		},
+		{
+			name: "Userinfo changed while connected",
+			id:   "2",
+			fields: Parser{
+				gameCounter: 1,
+				clients:     map[string]string{"2": "Isgalamido"},
+				sessions:    map[string]int{"2": 1238},
+				log: map[string]Game{"game_01": {Connections: map[string]*Connection{
+					"Isgalamido": {JoinedAt: 1238, PresentAtEnd: true},
+				}}},
+			},
+			wantConnections: map[string]*Connection{
+				"Isgalamido": {JoinedAt: 1238, PresentAtEnd: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fields.joinClient(tt.id, tt.renamed)
+			assert.Equal(t, tt.wantConnections, tt.fields.log["game_01"].Connections)
+		})
+	}
+}
+
+func TestParser_renameClient(t *testing.T) {
+	tests := []struct {
+		name            string
+		id              string
+		player          string
+		fields          Parser
+		wantRenamed     bool
+		wantSessions    map[string]int
+		wantConnections map[string]*Connection
+	}{
+		{
+			name:   "Same name",
+			id:     "3",
+			player: "Dono da Bola",
+			fields: Parser{
+				line:        " 21:53 ClientUserinfoChanged: 3 n\\Dono da Bola\\t\\0",
+				gameCounter: 1,
+				clients:     map[string]string{"3": "Dono da Bola"},
+				sessions:    map[string]int{"3": 1311},
+				log: map[string]Game{"game_01": {Connections: map[string]*Connection{
+					"Dono da Bola": {JoinedAt: 1311, PresentAtEnd: true},
+				}}},
+			},
+			wantSessions: map[string]int{"3": 1311},
+			wantConnections: map[string]*Connection{
+				"Dono da Bola": {JoinedAt: 1311, PresentAtEnd: true},
+			},
+		},
+		{
+			name:   "New name",
+			id:     "3",
+			player: "Mocinha",
+			fields: Parser{
+				line:        " 21:53 ClientUserinfoChanged: 3 n\\Mocinha\\t\\0",
+				gameCounter: 1,
+				clients:     map[string]string{"3": "Dono da Bola"},
+				sessions:    map[string]int{"3": 1311},
+				log: map[string]Game{"game_01": {Connections: map[string]*Connection{
+					"Dono da Bola": {JoinedAt: 1311, PresentAtEnd: true},
+				}}},
+			},
+			wantRenamed:  true,
+			wantSessions: map[string]int{"3": 1313},
+			wantConnections: map[string]*Connection{
+				"Dono da Bola": {JoinedAt: 1311, LeftAt: 1313, TimeOnServer: 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantRenamed, tt.fields.renameClient(tt.id, tt.player))
+			assert.Equal(t, tt.wantSessions, tt.fields.sessions)
+			assert.Equal(t, tt.wantConnections, tt.fields.log["game_01"].Connections)
+		})
+	}
+}
+
+func TestParser_endGame(t *testing.T) {
+	p := Parser{
+		gameCounter: 1,
+		clients:     map[string]string{"2": "Isgalamido", "3": "Zeh"},
+		sessions:    map[string]int{"2": 1238, "3": 1300},
+		log: map[string]Game{"game_01": {Connections: map[string]*Connection{
+			"Isgalamido": {JoinedAt: 1238, PresentAtEnd: true},
+			"Zeh":        {JoinedAt: 1240, LeftAt: 1250, TimeOnServer: 10, Reconnects: 1, PresentAtEnd: true},
+			"Mocinha":    {JoinedAt: 1313, LeftAt: 1331, TimeOnServer: 18},
+		}}},
+	}
+
+	p.endGame(1400)
+	assert.Empty(t, p.sessions)
+	assert.Equal(t, map[string]*Connection{
+		"Isgalamido": {JoinedAt: 1238, LeftAt: 1400, TimeOnServer: 162, PresentAtEnd: true},
+		"Zeh":        {JoinedAt: 1240, LeftAt: 1400, TimeOnServer: 110, Reconnects: 1, PresentAtEnd: true},
+		"Mocinha":    {JoinedAt: 1313, LeftAt: 1331, TimeOnServer: 18},
+	}, p.log["game_01"].Connections)
+}
+
+func TestParser_ParseLine_renamedBack(t *testing.T) {
+	p := Parser{}
+	for _, line := range []string{
+		`  0:00 InitGame: \sv_hostname\Code Miner Server\g_gametype\0\mapname\q3dm17\gamename\baseq3`,
+		`  0:25 ClientConnect: 2`,
+		`  0:25 ClientUserinfoChanged: 2 n\Dono da Bola\t\0\model\sarge/krusade\hmodel\sarge/krusade`,
+		`  0:27 ClientUserinfoChanged: 2 n\Mocinha\t\0\model\sarge\hmodel\sarge`,
+		`  0:27 ClientBegin: 2`,
+		`  1:26 ClientUserinfoChanged: 2 n\Dono da Bola\t\0\model\sarge\hmodel\sarge`,
+	} {
+		p.ParseLine(line)
+	}
+
+	assert.Equal(t, map[string]*Connection{
+		"Dono da Bola": {JoinedAt: 25, LeftAt: 27, TimeOnServer: 2, PresentAtEnd: true},
+		"Mocinha":      {JoinedAt: 27, LeftAt: 86, TimeOnServer: 59},
+	}, p.log["game_01"].Connections)
+}
+
+func TestParser_endGame_leaveOrder(t *testing.T) {
+	var leaves []string
+	p := Parser{
+		OnEvent: func(event Event) {
+			if event.Type == EventLeave {
+				leaves = append(leaves, event.Player)
+			}
+		},
+		gameCounter: 1,
+		clients:     map[string]string{"2": "Isgalamido", "3": "Zeh", "10": "Mocinha", "4": "Oootsimo"},
+		sessions:    map[string]int{"2": 10, "3": 10, "10": 10, "4": 10},
+		log: map[string]Game{"game_01": {Connections: map[string]*Connection{
+			"Isgalamido": {JoinedAt: 10, PresentAtEnd: true},
+			"Zeh":        {JoinedAt: 10, PresentAtEnd: true},
+			"Mocinha":    {JoinedAt: 10, PresentAtEnd: true},
+			"Oootsimo":   {JoinedAt: 10, PresentAtEnd: true},
+		}}},
+	}
+
+	p.endGame(20)
+	assert.Equal(t, []string{"Isgalamido", "Zeh", "Oootsimo", "Mocinha"}, leaves)
+}
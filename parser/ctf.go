@@ -0,0 +1,226 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+)
+
+type (
+	// CTF holds the flag statistics of a capture the flag game, by player and by team.
+	CTF struct {
+		Players map[string]*FlagStats `json:"players"`
+		Teams   map[string]*FlagStats `json:"teams"`
+	}
+
+	FlagStats struct {
+		Pickups      int `json:"pickups"`
+		Captures     int `json:"captures"`
+		Returns      int `json:"returns"`
+		CarrierKills int `json:"carrier_kills"`
+	}
+
+	// flagState is the position of a flag; the zero value means the flag is at its base.
+	flagState struct {
+		carrier   string
+		dropped   bool
+		droppedAt int
+	}
+)
+
+var ctfLine = regexp.MustCompile(`CTF: (\d+) \d+ (\d+):`)
+
+// flagReturnTime is how long, in seconds, a dropped flag stays on the ground before
+// the server returns it to its base.
+const flagReturnTime = 30
+
+const (
+	ctfEventPickup      = "0"
+	ctfEventCapture     = "1"
+	ctfEventReturn      = "2"
+	ctfEventCarrierKill = "3"
+)
+
+var returnsFlag = map[string]bool{
+	"MOD_TRIGGER_HURT": true,
+	"MOD_LAVA":         true,
+	"MOD_SLIME":        true,
+}
+
+// addFlag handles the flag lines. Baseq3 logs every flag touch the same way, as a team_CTF_*flag
+// item, so pickups, captures and returns are told apart by the flag states. This is a best effort:
+// a flag falling in a pit after being dropped is not logged at all. The dialects logging what
+// happened to the flag are taken at their word.
+func (p *Parser) addFlag() bool {
+	if p.errorState || p.gameDialect().Recognize(p.line) != LineFlag {
+		return false
+	}
+	if p.ctfEvents {
+		return true
+	}
+
+	line, ok := p.gameDialect().Decode(LineFlag, p.line)
+	if !ok {
+		p.errorState = true
+		return true
+	}
+
+	game := p.log[p.gameKey()]
+	if game.CTF == nil || game.Teams == nil {
+		return true
+	}
+
+	player := p.clients[line.ClientID]
+	team, flag := game.Teams.Players[player], line.Flag
+	if player == "" || (team != TeamRed && team != TeamBlue) {
+		return true
+	}
+
+	switch line.FlagAction {
+	case FlagPickedUp:
+		if flag != team {
+			p.flags[flag] = flagState{carrier: player}
+			p.addFlagStats(player, team, func(s *FlagStats) { s.Pickups++ })
+		}
+	case FlagDropped:
+		p.flags[flag] = flagState{dropped: true, droppedAt: p.timestamp()}
+	case FlagReturned:
+		p.flags[flag] = flagState{}
+		p.addFlagStats(player, team, func(s *FlagStats) { s.Returns++ })
+	case FlagCaptured:
+		p.flags[flag] = flagState{}
+		p.addFlagStats(player, team, func(s *FlagStats) { s.Captures++ })
+	default:
+		p.touchFlag(player, team, flag)
+	}
+	return true
+}
+
+// touchFlag infers what a touch of the flag by the player was from the flag states.
+func (p *Parser) touchFlag(player, team, flag string) {
+	if flag != team {
+		p.flags[flag] = flagState{carrier: player}
+		p.addFlagStats(player, team, func(s *FlagStats) { s.Pickups++ })
+		return
+	}
+
+	if p.flagState(flag).dropped {
+		p.flags[flag] = flagState{}
+		p.addFlagStats(player, team, func(s *FlagStats) { s.Returns++ })
+		return
+	}
+
+	enemy := enemyTeam(team)
+	if p.flagState(enemy).carrier == player {
+		p.flags[enemy] = flagState{}
+		p.addFlagStats(player, team, func(s *FlagStats) { s.Captures++ })
+	}
+}
+
+// addCTFEvent handles the "CTF: <client> <team> <event>:" lines some mods write for
+// every flag event. Once a game has them, they replace the flag touch inference.
+func (p *Parser) addCTFEvent() bool {
+	if p.errorState || !strings.Contains(p.line, "CTF:") {
+		return false
+	}
+
+	matches := ctfLine.FindStringSubmatch(p.line)
+	if len(matches) < 3 {
+		p.errorState = true
+		return true
+	}
+
+	game := p.log[p.gameKey()]
+	if game.CTF == nil || game.Teams == nil {
+		return true
+	}
+
+	if !p.ctfEvents {
+		p.ctfEvents = true
+		game.CTF.Players = make(map[string]*FlagStats)
+		game.CTF.Teams = make(map[string]*FlagStats)
+		for _, player := range game.Players {
+			p.addScoreboardPlayer(player)
+		}
+	}
+
+	player := p.clients[matches[1]]
+	team := game.Teams.Players[player]
+	switch matches[2] {
+	case ctfEventPickup:
+		p.addFlagStats(player, team, func(s *FlagStats) { s.Pickups++ })
+	case ctfEventCapture:
+		p.addFlagStats(player, team, func(s *FlagStats) { s.Captures++ })
+	case ctfEventReturn:
+		p.addFlagStats(player, team, func(s *FlagStats) { s.Returns++ })
+	case ctfEventCarrierKill:
+		p.addFlagStats(player, team, func(s *FlagStats) { s.CarrierKills++ })
+	}
+	return true
+}
+
+// dropFlag drops any flag carried by the victim, crediting the killer with a carrier kill.
+// Flags dropped in pits, lava or slime go straight back to their base.
+func (p *Parser) dropFlag(killer, victim, weapon string) {
+	game := p.log[p.gameKey()]
+	if game.CTF == nil || p.ctfEvents {
+		return
+	}
+
+	for flag := range p.flags {
+		if p.flags[flag].carrier != victim {
+			continue
+		}
+
+		if returnsFlag[weapon] {
+			p.flags[flag] = flagState{}
+			continue
+		}
+
+		p.flags[flag] = flagState{dropped: true, droppedAt: p.timestamp()}
+		if killer == victim || killer == "<world>" || game.Teams == nil {
+			continue
+		}
+		p.addFlagStats(killer, game.Teams.Players[killer], func(s *FlagStats) { s.CarrierKills++ })
+	}
+}
+
+// flagState returns the state of a flag, taking the automatic return of dropped flags into account.
+func (p *Parser) flagState(flag string) flagState {
+	state := p.flags[flag]
+	if state.dropped && p.timestamp()-state.droppedAt >= flagReturnTime {
+		return flagState{}
+	}
+	return state
+}
+
+func (p *Parser) addFlagStats(player, team string, add func(*FlagStats)) {
+	ctf := p.log[p.gameKey()].CTF
+	if _, ok := ctf.Players[player]; !ok {
+		ctf.Players[player] = &FlagStats{}
+	}
+	add(ctf.Players[player])
+
+	if team != TeamRed && team != TeamBlue {
+		return
+	}
+	if _, ok := ctf.Teams[team]; !ok {
+		ctf.Teams[team] = &FlagStats{}
+	}
+	add(ctf.Teams[team])
+}
+
+func enemyTeam(team string) string {
+	if team == TeamRed {
+		return TeamBlue
+	}
+	return TeamRed
+}
+
+// returnFlags sends the flags carried by a leaving player back to their base.
+func (p *Parser) returnFlags(player string) {
+	for flag, state := range p.flags {
+		if state.carrier == player {
+			p.flags[flag] = flagState{}
+		}
+	}
+}
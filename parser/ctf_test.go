@@ -0,0 +1,368 @@
+//go:build unit
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newCTFGame() map[string]Game {
+	return map[string]Game{
+		"game_01": {
+			Teams: &Teams{
+				Players: map[string]string{
+					"Isgalamido":   TeamRed,
+					"Dono da Bola": TeamRed,
+					"Zeh":          TeamBlue,
+					"Mal":          TeamSpectator,
+				},
+			},
+			CTF: &CTF{
+				Players: make(map[string]*FlagStats),
+				Teams:   make(map[string]*FlagStats),
+			},
+		},
+	}
+}
+
+func TestParser_addFlag(t *testing.T) {
+	clients := map[string]string{"2": "Isgalamido", "3": "Dono da Bola", "4": "Zeh", "8": "Mal"}
+
+	tests := []struct {
+		name        string
+		fields      Parser
+		wantFlags   map[string]flagState
+		wantCTF     *CTF
+		wantError   bool
+		expectedRes bool
+	}{
+		{
+			name:        "Not flag line",
+			fields:      Parser{line: "  2:41 Item: 8 weapon_rocketlauncher", gameCounter: 1, log: newCTFGame()},
+			wantCTF:     &CTF{Players: map[string]*FlagStats{}, Teams: map[string]*FlagStats{}},
+			expectedRes: false,
+		},
+		{
+			name:        "Error in regex",
+			fields:      Parser{line: "  2:41 Item: team_CTF_redflag", gameCounter: 1, log: newCTFGame()},
+			wantCTF:     &CTF{Players: map[string]*FlagStats{}, Teams: map[string]*FlagStats{}},
+			wantError:   true,
+			expectedRes: true,
+		},
+		{
+			name: "Pickup",
+			fields: Parser{
+				line:        "  2:45 Item: 2 team_CTF_blueflag",
+				gameCounter: 1,
+				clients:     clients,
+				flags:       map[string]flagState{},
+				log:         newCTFGame(),
+			},
+			wantFlags: map[string]flagState{TeamBlue: {carrier: "Isgalamido"}},
+			wantCTF: &CTF{
+				Players: map[string]*FlagStats{"Isgalamido": {Pickups: 1}},
+				Teams:   map[string]*FlagStats{TeamRed: {Pickups: 1}},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Capture",
+			fields: Parser{
+				line:        "  3:05 Item: 2 team_CTF_redflag",
+				gameCounter: 1,
+				clients:     clients,
+				flags:       map[string]flagState{TeamBlue: {carrier: "Isgalamido"}},
+				log:         newCTFGame(),
+			},
+			wantFlags: map[string]flagState{TeamBlue: {}},
+			wantCTF: &CTF{
+				Players: map[string]*FlagStats{"Isgalamido": {Captures: 1}},
+				Teams:   map[string]*FlagStats{TeamRed: {Captures: 1}},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Return",
+			fields: Parser{
+				line:        "  3:05 Item: 3 team_CTF_redflag",
+				gameCounter: 1,
+				clients:     clients,
+				flags:       map[string]flagState{TeamRed: {dropped: true, droppedAt: 180}},
+				log:         newCTFGame(),
+			},
+			wantFlags: map[string]flagState{TeamRed: {}},
+			wantCTF: &CTF{
+				Players: map[string]*FlagStats{"Dono da Bola": {Returns: 1}},
+				Teams:   map[string]*FlagStats{TeamRed: {Returns: 1}},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Capture after the dropped flag went back home",
+			fields: Parser{
+				line:        "  3:45 Item: 2 team_CTF_redflag",
+				gameCounter: 1,
+				clients:     clients,
+				flags: map[string]flagState{
+					TeamRed:  {dropped: true, droppedAt: 180},
+					TeamBlue: {carrier: "Isgalamido"},
+				},
+				log: newCTFGame(),
+			},
+			wantFlags: map[string]flagState{
+				TeamRed:  {dropped: true, droppedAt: 180},
+				TeamBlue: {},
+			},
+			wantCTF: &CTF{
+				Players: map[string]*FlagStats{"Isgalamido": {Captures: 1}},
+				Teams:   map[string]*FlagStats{TeamRed: {Captures: 1}},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Player without team",
+			fields: Parser{
+				line:        "  2:45 Item: 8 team_CTF_blueflag",
+				gameCounter: 1,
+				clients:     clients,
+				flags:       map[string]flagState{},
+				log:         newCTFGame(),
+			},
+			wantFlags:   map[string]flagState{},
+			wantCTF:     &CTF{Players: map[string]*FlagStats{}, Teams: map[string]*FlagStats{}},
+			expectedRes: true,
+		},
+		{
+			name: "Explicit CTF events already seen",
+			fields: Parser{
+				line:        "  2:45 Item: 2 team_CTF_blueflag",
+				gameCounter: 1,
+				clients:     clients,
+				flags:       map[string]flagState{},
+				ctfEvents:   true,
+				log:         newCTFGame(),
+			},
+			wantFlags:   map[string]flagState{},
+			wantCTF:     &CTF{Players: map[string]*FlagStats{}, Teams: map[string]*FlagStats{}},
+			expectedRes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.fields.addFlag()
+			assert.Equal(t, tt.expectedRes, res)
+			assert.Equal(t, tt.wantError, tt.fields.errorState)
+			assert.Equal(t, tt.wantFlags, tt.fields.flags)
+			assert.Equal(t, tt.wantCTF, tt.fields.log["game_01"].CTF)
+		})
+	}
+}
+
+func TestParser_addCTFEvent(t *testing.T) {
+	clients := map[string]string{"2": "Isgalamido", "4": "Zeh"}
+
+	tests := []struct {
+		name        string
+		fields      Parser
+		wantCTF     *CTF
+		expectedRes bool
+	}{
+		{
+			name:        "Not CTF line",
+			fields:      Parser{line: "  2:41 Item: 4 team_CTF_redflag", gameCounter: 1, log: newCTFGame()},
+			wantCTF:     &CTF{Players: map[string]*FlagStats{}, Teams: map[string]*FlagStats{}},
+			expectedRes: false,
+		},
+		{
+			name: "Inferred stats are replaced",
+			fields: Parser{
+				line:        "  2:41 CTF: 4 1 0: Zeh got the RED flag!",
+				gameCounter: 1,
+				clients:     clients,
+				log: func() map[string]Game {
+					log := newCTFGame()
+					log["game_01"].CTF.Players["Zeh"] = &FlagStats{Pickups: 1}
+					log["game_01"].CTF.Teams[TeamBlue] = &FlagStats{Pickups: 1}
+					return log
+				}(),
+			},
+			wantCTF: &CTF{
+				Players: map[string]*FlagStats{"Zeh": {Pickups: 1}},
+				Teams:   map[string]*FlagStats{TeamBlue: {Pickups: 1}},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Capture",
+			fields: Parser{
+				line:        "  3:02 CTF: 4 1 1: Zeh captured the RED flag!",
+				gameCounter: 1,
+				clients:     clients,
+				ctfEvents:   true,
+				log:         newCTFGame(),
+			},
+			wantCTF: &CTF{
+				Players: map[string]*FlagStats{"Zeh": {Captures: 1}},
+				Teams:   map[string]*FlagStats{TeamBlue: {Captures: 1}},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Return",
+			fields: Parser{
+				line:        "  3:02 CTF: 2 1 2: Isgalamido returned the RED flag!",
+				gameCounter: 1,
+				clients:     clients,
+				ctfEvents:   true,
+				log:         newCTFGame(),
+			},
+			wantCTF: &CTF{
+				Players: map[string]*FlagStats{"Isgalamido": {Returns: 1}},
+				Teams:   map[string]*FlagStats{TeamRed: {Returns: 1}},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Carrier kill",
+			fields: Parser{
+				line:        "  3:02 CTF: 2 1 3: Isgalamido fragged BLUE's flag carrier!",
+				gameCounter: 1,
+				clients:     clients,
+				ctfEvents:   true,
+				log:         newCTFGame(),
+			},
+			wantCTF: &CTF{
+				Players: map[string]*FlagStats{"Isgalamido": {CarrierKills: 1}},
+				Teams:   map[string]*FlagStats{TeamRed: {CarrierKills: 1}},
+			},
+			expectedRes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.fields.addCTFEvent()
+			assert.Equal(t, tt.expectedRes, res)
+			assert.Equal(t, tt.wantCTF, tt.fields.log["game_01"].CTF)
+		})
+	}
+}
+
+func TestParser_dropFlag(t *testing.T) {
+	tests := []struct {
+		name      string
+		killer    string
+		victim    string
+		weapon    string
+		fields    Parser
+		wantFlags map[string]flagState
+		wantCTF   *CTF
+	}{
+		{
+			name:   "Victim without flag",
+			killer: "Zeh",
+			victim: "Dono da Bola",
+			weapon: "MOD_ROCKET",
+			fields: Parser{
+				line:        "  2:50 Kill: 4 3 6: Zeh killed Dono da Bola by MOD_ROCKET",
+				gameCounter: 1,
+				flags:       map[string]flagState{TeamBlue: {carrier: "Isgalamido"}},
+				log:         newCTFGame(),
+			},
+			wantFlags: map[string]flagState{TeamBlue: {carrier: "Isgalamido"}},
+			wantCTF:   &CTF{Players: map[string]*FlagStats{}, Teams: map[string]*FlagStats{}},
+		},
+		{
+			name:   "Carrier kill",
+			killer: "Zeh",
+			victim: "Isgalamido",
+			weapon: "MOD_ROCKET",
+			fields: Parser{
+				line:        "  2:50 Kill: 4 2 6: Zeh killed Isgalamido by MOD_ROCKET",
+				gameCounter: 1,
+				flags:       map[string]flagState{TeamBlue: {carrier: "Isgalamido"}},
+				log:         newCTFGame(),
+			},
+			wantFlags: map[string]flagState{TeamBlue: {dropped: true, droppedAt: 170}},
+			wantCTF: &CTF{
+				Players: map[string]*FlagStats{"Zeh": {CarrierKills: 1}},
+				Teams:   map[string]*FlagStats{TeamBlue: {CarrierKills: 1}},
+			},
+		},
+		{
+			name:   "Carrier falling in a pit",
+			killer: "<world>",
+			victim: "Isgalamido",
+			weapon: "MOD_TRIGGER_HURT",
+			fields: Parser{
+				line:        "  2:50 Kill: 1022 2 22: <world> killed Isgalamido by MOD_TRIGGER_HURT",
+				gameCounter: 1,
+				flags:       map[string]flagState{TeamBlue: {carrier: "Isgalamido"}},
+				log:         newCTFGame(),
+			},
+			wantFlags: map[string]flagState{TeamBlue: {}},
+			wantCTF:   &CTF{Players: map[string]*FlagStats{}, Teams: map[string]*FlagStats{}},
+		},
+		{
+			name:   "Carrier killing himself",
+			killer: "Isgalamido",
+			victim: "Isgalamido",
+			weapon: "MOD_ROCKET_SPLASH",
+			fields: Parser{
+				line:        "  2:50 Kill: 2 2 7: Isgalamido killed Isgalamido by MOD_ROCKET_SPLASH",
+				gameCounter: 1,
+				flags:       map[string]flagState{TeamBlue: {carrier: "Isgalamido"}},
+				log:         newCTFGame(),
+			},
+			wantFlags: map[string]flagState{TeamBlue: {dropped: true, droppedAt: 170}},
+			wantCTF:   &CTF{Players: map[string]*FlagStats{}, Teams: map[string]*FlagStats{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fields.dropFlag(tt.killer, tt.victim, tt.weapon)
+			assert.Equal(t, tt.wantFlags, tt.fields.flags)
+			assert.Equal(t, tt.wantCTF, tt.fields.log["game_01"].CTF)
+		})
+	}
+}
+
+func TestParser_flagState(t *testing.T) {
+	tests := []struct {
+		name   string
+		flag   string
+		fields Parser
+		want   flagState
+	}{
+		{
+			name:   "At base",
+			flag:   TeamRed,
+			fields: Parser{line: "  3:00 Item: 2 team_CTF_redflag", flags: map[string]flagState{}},
+			want:   flagState{},
+		},
+		{
+			name:   "Dropped",
+			flag:   TeamRed,
+			fields: Parser{line: "  3:00 Item: 2 team_CTF_redflag", flags: map[string]flagState{TeamRed: {dropped: true, droppedAt: 170}}},
+			want:   flagState{dropped: true, droppedAt: 170},
+		},
+		{
+			name:   "Dropped and returned by the server",
+			flag:   TeamRed,
+			fields: Parser{line: "  3:20 Item: 2 team_CTF_redflag", flags: map[string]flagState{TeamRed: {dropped: true, droppedAt: 170}}},
+			want:   flagState{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tt.fields.flagState(tt.flag)
+			assert.Equal(t, tt.want, state)
+		})
+	}
+}
+
+func Test_enemyTeam(t *testing.T) {
+	assert.Equal(t, TeamBlue, enemyTeam(TeamRed))
+	assert.Equal(t, TeamRed, enemyTeam(TeamBlue))
+}
@@ -0,0 +1,252 @@
+package parser
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Dialect recognizes and decodes the lines whose format differs between the Quake-engine games:
+	// the kills, the player infos, the chat and the flags. The other lines are shared by every game.
+	Dialect interface {
+		// Name names the dialect, e.g. baseq3.
+		Name() string
+		// Detect reports whether a game, by the gamename and version of its InitGame line, is
+		// logged in the dialect.
+		Detect(gameName, version string) bool
+		// Recognize returns the kind of the line, LineOther for the lines shared by every game.
+		Recognize(line string) LineKind
+		// Decode decodes a line of the kind, false when it is malformed.
+		Decode(kind LineKind, line string) (Line, bool)
+		// GameType reports whether the g_gametype is played in teams, and whether it is capture the flag.
+		GameType(gameType int) (teams, ctf bool)
+	}
+
+	// LineKind is the kind of a line a dialect decodes.
+	LineKind int
+
+	// FlagAction is what a flag line logs of the flag, FlagTouched when the dialect does not tell.
+	FlagAction int
+
+	// Line is a decoded line, its fields set by kind.
+	Line struct {
+		// ClientID is the client of a userinfo line, and of a say line when the dialect logs it.
+		ClientID string
+		// To and ToClientID are the player a tell line is sent to, as logged, and its client when
+		// the dialect logs it instead.
+		To         string
+		ToClientID string
+		// KillerID, VictimID and MeansID are the IDs of a kill line.
+		KillerID string
+		VictimID string
+		MeansID  string
+		// Text is the text of a kill or say line, after its IDs.
+		Text string
+		// Killer and Victim are the players of a kill line as its text names them, empty when it does not.
+		Killer string
+		Victim string
+		// Means is the means of death of a kill line, by its ID when the dialect knows it, else as logged.
+		Means string
+		// Name is the player of a userinfo or say line, as logged.
+		Name string
+		// Team is the team ID of a userinfo line, empty when it has none.
+		Team string
+		// Message and TeamChat are the message of a say line and whether it was only sent to the team.
+		Message  string
+		TeamChat bool
+		// Flag and FlagAction are the flag of a flag line, red or blue, and what happened to it.
+		Flag       string
+		FlagAction FlagAction
+	}
+
+	// Baseq3 is the dialect of ioq3 baseq3, the default one.
+	Baseq3 struct{}
+
+	// OpenArena is the dialect of OpenArena, numbering the means of death as Team Arena does.
+	OpenArena struct {
+		Baseq3
+	}
+)
+
+const (
+	LineOther LineKind = iota
+	LineSay
+	LineUserinfo
+	LineKill
+	LineFlag
+)
+
+const (
+	// FlagTouched is a touch of the flag, a pickup, capture or return told apart by the flag states.
+	FlagTouched FlagAction = iota
+	FlagPickedUp
+	FlagDropped
+	FlagReturned
+	FlagCaptured
+)
+
+// dialects are the dialects detected, in order, Baseq3 being the fallback.
+var dialects = []Dialect{OpenArena{}, UrbanTerror{}, Baseq3{}}
+
+var (
+	sayLine        = regexp.MustCompile(`^\s*\d+:\d{2} (?:say|sayteam|tell): `)
+	sayText        = regexp.MustCompile(`^\s*\d+:\d{2} (say|sayteam|tell): (.*)$`)
+	tellText       = regexp.MustCompile(`^(.+?) to (.+?): (.*)$`)
+	killLine       = regexp.MustCompile(`Kill: (\d+) (\d+) (\d+): (.*)`)
+	killText       = regexp.MustCompile(`^(.+?) killed (.+) by (\S+)\s*$`)
+	userinfoLine   = regexp.MustCompile(`ClientUserinfoChanged: (\d+) n\\(.+?)\\t\\(\d+)(?:\\|$)`)
+	userinfoNoTeam = regexp.MustCompile(`ClientUserinfoChanged: (\d+) n\\([^\\]+)`)
+	flagItem       = regexp.MustCompile(`Item: (\d+) team_CTF_(red|blue)flag`)
+)
+
+// DetectDialect returns the dialect of a game by the gamename and version of its InitGame line.
+func DetectDialect(gameName, version string) Dialect {
+	for _, dialect := range dialects {
+		if dialect.Detect(gameName, version) {
+			return dialect
+		}
+	}
+	return Baseq3{}
+}
+
+// DialectByName returns the dialect of the name, e.g. urbanterror.
+func DialectByName(name string) (Dialect, bool) {
+	for _, dialect := range dialects {
+		if dialect.Name() == name {
+			return dialect, true
+		}
+	}
+	return nil, false
+}
+
+func (Baseq3) Name() string {
+	return "baseq3"
+}
+
+func (Baseq3) Detect(gameName, _ string) bool {
+	return gameName == "baseq3"
+}
+
+func (Baseq3) Recognize(line string) LineKind {
+	switch {
+	case sayLine.MatchString(line):
+		return LineSay
+	case strings.Contains(line, "ClientUserinfoChanged:"):
+		return LineUserinfo
+	case strings.Contains(line, "Kill:"):
+		return LineKill
+	case strings.Contains(line, "Item:") && strings.Contains(line, "team_CTF_"):
+		return LineFlag
+	}
+	return LineOther
+}
+
+func (Baseq3) Decode(kind LineKind, line string) (Line, bool) {
+	switch kind {
+	case LineSay:
+		return decodeSay(line)
+	case LineUserinfo:
+		return decodeUserinfo(line)
+	case LineKill:
+		kill, ok := decodeKill(line)
+		if !ok {
+			return Line{}, false
+		}
+		// The builds without Team Arena number MOD_GRAPPLE as Team Arena numbers MOD_NAIL.
+		if id, _ := strconv.Atoi(kill.MeansID); id != baseq3GrappleID || kill.Means != ModGrapple.String() {
+			kill.Means = meansName(kill.MeansID, kill.Means)
+		}
+		return kill, true
+	case LineFlag:
+		return decodeFlagItem(line)
+	}
+	return Line{}, false
+}
+
+func (Baseq3) GameType(gameType int) (teams, ctf bool) {
+	return gameType >= GameTypeTeam, gameType == GameTypeCTF
+}
+
+func (OpenArena) Name() string {
+	return "openarena"
+}
+
+func (OpenArena) Detect(gameName, version string) bool {
+	return gameName == "baseoa" || strings.Contains(version, "+oa")
+}
+
+func (d OpenArena) Decode(kind LineKind, line string) (Line, bool) {
+	if kind != LineKill {
+		return d.Baseq3.Decode(kind, line)
+	}
+
+	kill, ok := decodeKill(line)
+	if !ok {
+		return Line{}, false
+	}
+	kill.Means = meansName(kill.MeansID, kill.Means)
+	return kill, true
+}
+
+// decodeSay decodes the "say: <name>: <message>" and "tell: <name> to <name>: <message>" lines,
+// names containing ": " or " to " being split at their first one.
+func decodeSay(line string) (Line, bool) {
+	matches := sayText.FindStringSubmatch(line)
+	if matches == nil {
+		return Line{}, false
+	}
+
+	say := Line{Text: matches[2], TeamChat: matches[1] == "sayteam"}
+	if matches[1] == "tell" {
+		tell := tellText.FindStringSubmatch(say.Text)
+		if tell == nil {
+			return Line{}, false
+		}
+		say.Name, say.To, say.Message = tell[1], tell[2], tell[3]
+		return say, true
+	}
+
+	name, message, found := strings.Cut(say.Text, ": ")
+	if !found || name == "" {
+		return Line{}, false
+	}
+	say.Name, say.Message = name, message
+	return say, true
+}
+
+// decodeUserinfo decodes the name and team of the ClientUserinfoChanged lines. Names may contain
+// backslashes, so the name ends at the team key when there is one.
+func decodeUserinfo(line string) (Line, bool) {
+	if matches := userinfoLine.FindStringSubmatch(line); matches != nil {
+		return Line{ClientID: matches[1], Name: matches[2], Team: matches[3]}, true
+	}
+	if matches := userinfoNoTeam.FindStringSubmatch(line); matches != nil {
+		return Line{ClientID: matches[1], Name: matches[2]}, true
+	}
+	return Line{}, false
+}
+
+// decodeFlagItem decodes the team_CTF_*flag item lines, logged for every touch of a flag.
+func decodeFlagItem(line string) (Line, bool) {
+	matches := flagItem.FindStringSubmatch(line)
+	if matches == nil {
+		return Line{}, false
+	}
+	return Line{ClientID: matches[1], Flag: matches[2]}, true
+}
+
+// decodeKill decodes the IDs of the Kill lines, and the names of their text when it has them,
+// the means of death being left as logged.
+func decodeKill(line string) (Line, bool) {
+	matches := killLine.FindStringSubmatch(line)
+	if matches == nil {
+		return Line{}, false
+	}
+
+	kill := Line{KillerID: matches[1], VictimID: matches[2], MeansID: matches[3], Text: matches[4]}
+	if text := killText.FindStringSubmatch(kill.Text); text != nil {
+		kill.Killer, kill.Victim, kill.Means = text[1], text[2], text[3]
+	}
+	return kill, true
+}
@@ -0,0 +1,278 @@
+//go:build unit
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectDialect(t *testing.T) {
+	tests := []struct {
+		name     string
+		gameName string
+		version  string
+		want     Dialect
+	}{
+		{name: "baseq3", gameName: "baseq3", version: "ioq3 1.36 linux-x86_64 Apr 12 2009", want: Baseq3{}},
+		{name: "OpenArena by gamename", gameName: "baseoa", version: "ioq3 1.36", want: OpenArena{}},
+		{name: "OpenArena by version", gameName: "", version: "ioq3+oa 1.36_GIT linux-x86_64", want: OpenArena{}},
+		{name: "Urban Terror 4.1", gameName: "q3ut4", version: "ioq3 1.35 urt 4.1.1", want: UrbanTerror{}},
+		{name: "Urban Terror 4.3", gameName: "q3urt43", version: "ioq3 1.35 urt 4.3.4", want: UrbanTerror{}},
+		{name: "Unknown", gameName: "missionpack", version: "ioq3 1.36", want: Baseq3{}},
+		{name: "Nothing", want: Baseq3{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DetectDialect(tt.gameName, tt.version))
+		})
+	}
+}
+
+func TestDialect_Decode(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  Dialect
+		line     string
+		wantKind LineKind
+		want     Line
+		wantOk   bool
+	}{
+		{
+			name:     "Other line",
+			dialect:  Baseq3{},
+			line:     " 20:40 Item: 2 weapon_rocketlauncher",
+			wantKind: LineOther,
+		},
+		{
+			name:     "Say",
+			dialect:  Baseq3{},
+			line:     "981:21 sayteam: Oootsimo: team red",
+			wantKind: LineSay,
+			want:     Line{Text: "Oootsimo: team red", Name: "Oootsimo", Message: "team red", TeamChat: true},
+			wantOk:   true,
+		},
+		{
+			name:     "Say mentioning a kill",
+			dialect:  Baseq3{},
+			line:     "  1:02 say: Zeh: Kill: 1 2 3: nope",
+			wantKind: LineSay,
+			want:     Line{Text: "Zeh: Kill: 1 2 3: nope", Name: "Zeh", Message: "Kill: 1 2 3: nope"},
+			wantOk:   true,
+		},
+		{
+			name:     "Tell",
+			dialect:  Baseq3{},
+			line:     "  1:02 tell: Zeh to Isgalamido: Kill: 1 2 3: nope",
+			wantKind: LineSay,
+			want:     Line{Text: "Zeh to Isgalamido: Kill: 1 2 3: nope", Name: "Zeh", To: "Isgalamido", Message: "Kill: 1 2 3: nope"},
+			wantOk:   true,
+		},
+		{
+			name:     "Malformed tell",
+			dialect:  Baseq3{},
+			line:     "  1:02 tell: Zeh: hi",
+			wantKind: LineSay,
+		},
+		{
+			name:     "Userinfo",
+			dialect:  Baseq3{},
+			line:     `  2:33 ClientUserinfoChanged: 4 n\Zeh\t\2\model\sarge/default`,
+			wantKind: LineUserinfo,
+			want:     Line{ClientID: "4", Name: "Zeh", Team: "2"},
+			wantOk:   true,
+		},
+		{
+			name:     "Malformed userinfo",
+			dialect:  Baseq3{},
+			line:     "  2:33 ClientUserinfoChanged: 4 ",
+			wantKind: LineUserinfo,
+		},
+		{
+			name:     "Kill",
+			dialect:  Baseq3{},
+			line:     "  2:40 Kill: 1022 2 22: <world> killed Isgalamido by MOD_TRIGGER_HURT",
+			wantKind: LineKill,
+			want:     Line{KillerID: "1022", VictimID: "2", MeansID: "22", Text: "<world> killed Isgalamido by MOD_TRIGGER_HURT", Killer: "<world>", Victim: "Isgalamido", Means: "MOD_TRIGGER_HURT"},
+			wantOk:   true,
+		},
+		{
+			name:     "Grapple of baseq3",
+			dialect:  Baseq3{},
+			line:     "  2:40 Kill: 3 2 23: Zeh killed Isgalamido by MOD_GRAPPLE",
+			wantKind: LineKill,
+			want:     Line{KillerID: "3", VictimID: "2", MeansID: "23", Text: "Zeh killed Isgalamido by MOD_GRAPPLE", Killer: "Zeh", Victim: "Isgalamido", Means: "MOD_GRAPPLE"},
+			wantOk:   true,
+		},
+		{
+			name:     "Nailgun of OpenArena",
+			dialect:  OpenArena{},
+			line:     "  2:40 Kill: 3 2 23: Zeh killed Isgalamido by MOD_GRAPPLE",
+			wantKind: LineKill,
+			want:     Line{KillerID: "3", VictimID: "2", MeansID: "23", Text: "Zeh killed Isgalamido by MOD_GRAPPLE", Killer: "Zeh", Victim: "Isgalamido", Means: "MOD_NAIL"},
+			wantOk:   true,
+		},
+		{
+			name:     "Flag touch",
+			dialect:  Baseq3{},
+			line:     " 12:35 Item: 4 team_CTF_blueflag",
+			wantKind: LineFlag,
+			want:     Line{ClientID: "4", Flag: "blue"},
+			wantOk:   true,
+		},
+		{
+			name:     "Flag line of Urban Terror in baseq3",
+			dialect:  Baseq3{},
+			line:     "  3:12 Flag: 0 2: team_CTF_blueflag",
+			wantKind: LineOther,
+		},
+		{
+			name:     "Flag pickup of Urban Terror",
+			dialect:  UrbanTerror{},
+			line:     "  3:12 Item: 0 team_CTF_blueflag",
+			wantKind: LineFlag,
+			want:     Line{ClientID: "0", Flag: "blue", FlagAction: FlagPickedUp},
+			wantOk:   true,
+		},
+		{
+			name:     "Flag capture of Urban Terror",
+			dialect:  UrbanTerror{},
+			line:     "  3:12 Flag: 0 2: team_CTF_blueflag",
+			wantKind: LineFlag,
+			want:     Line{ClientID: "0", Flag: "blue", FlagAction: FlagCaptured},
+			wantOk:   true,
+		},
+		{
+			name:     "Unknown flag action of Urban Terror",
+			dialect:  UrbanTerror{},
+			line:     "  3:12 Flag: 0 7: team_CTF_blueflag",
+			wantKind: LineFlag,
+		},
+		{
+			name:     "Say of Urban Terror",
+			dialect:  UrbanTerror{},
+			line:     "  3:10 say: 0 Gost: hello: all",
+			wantKind: LineSay,
+			want:     Line{ClientID: "0", Text: "Gost: hello: all", Name: "Gost", Message: "hello: all"},
+			wantOk:   true,
+		},
+		{
+			name:     "Saytell of Urban Terror",
+			dialect:  UrbanTerror{},
+			line:     "  3:11 saytell: 0 1 Gost: Kill: 0 1 15: hi",
+			wantKind: LineSay,
+			want:     Line{ClientID: "0", ToClientID: "1", Text: "Gost: Kill: 0 1 15: hi", Name: "Gost", Message: "Kill: 0 1 15: hi"},
+			wantOk:   true,
+		},
+		{
+			name:     "Kill of Urban Terror",
+			dialect:  UrbanTerror{},
+			line:     "  3:12 Kill: 0 1 15: Gost killed Ricky by UT_MOD_DEAGLE",
+			wantKind: LineKill,
+			want:     Line{KillerID: "0", VictimID: "1", MeansID: "15", Text: "Gost killed Ricky by UT_MOD_DEAGLE", Killer: "Gost", Victim: "Ricky", Means: "UT_MOD_DEAGLE"},
+			wantOk:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind := tt.dialect.Recognize(tt.line)
+			assert.Equal(t, tt.wantKind, kind)
+			if kind == LineOther {
+				return
+			}
+			got, ok := tt.dialect.Decode(kind, tt.line)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDialect_GameType(t *testing.T) {
+	tests := []struct {
+		name      string
+		dialect   Dialect
+		gameType  int
+		wantTeams bool
+		wantCTF   bool
+	}{
+		{name: "Free for all", dialect: Baseq3{}, gameType: 0},
+		{name: "Team deathmatch", dialect: Baseq3{}, gameType: 3, wantTeams: true},
+		{name: "Capture the flag", dialect: Baseq3{}, gameType: 4, wantTeams: true, wantCTF: true},
+		{name: "Team survivor of Urban Terror", dialect: UrbanTerror{}, gameType: 4, wantTeams: true},
+		{name: "Capture the flag of Urban Terror", dialect: UrbanTerror{}, gameType: 7, wantTeams: true, wantCTF: true},
+		{name: "Jump of Urban Terror", dialect: UrbanTerror{}, gameType: 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teams, ctf := tt.dialect.GameType(tt.gameType)
+			assert.Equal(t, tt.wantTeams, teams)
+			assert.Equal(t, tt.wantCTF, ctf)
+		})
+	}
+}
+
+func TestParser_ParseLine_urbanTerror(t *testing.T) {
+	lines := []string{
+		`  0:00 InitGame: \sv_hostname\UrT Server\g_gametype\7\gamename\q3urt43\mapname\ut4_turnpike\version\ioq3 1.35 urt 4.3.4`,
+		`  0:01 ClientUserinfoChanged: 0 n\Gost: x\t\1\r\0\tl\0\f0\\f1\\f2\\a0\0\a1\0\a2\0`,
+		`  0:01 ClientUserinfoChanged: 1 n\Gost\t\2\r\0\tl\0\f0\\f1\\f2\\a0\0\a1\0\a2\0`,
+		`  0:02 Kill: 0 1 15: Gost: x killed Gost by UT_MOD_DEAGLE`,
+		`  0:03 say: 1 Gost: x: nice`,
+		`  0:04 Kill: 1022 1 9: <world> killed Gost by MOD_TRIGGER_HURT`,
+		`  0:05 Item: 0 team_CTF_blueflag`,
+		`  0:06 Flag: 0 0: team_CTF_blueflag`,
+		`  0:07 Flag: 1 1: team_CTF_blueflag`,
+		`  0:08 Item: 0 team_CTF_blueflag`,
+		`  0:09 Flag: 0 2: team_CTF_blueflag`,
+		`  0:09 Flag Return: BLUE`,
+	}
+
+	p := Parser{}
+	for _, line := range lines {
+		p.ParseLine(line)
+	}
+	p.End()
+
+	game := p.log["game_01"]
+	assert.Equal(t, map[string]int{"UT_MOD_DEAGLE": 1, "MOD_TRIGGER_HURT": 1}, game.KillsByMeans)
+	assert.Equal(t, map[string]int{"Gost: x": 1, "Gost": -1}, game.Kills)
+	assert.Empty(t, game.Inconsistencies)
+	assert.NotNil(t, game.Teams)
+	if assert.NotNil(t, game.CTF) {
+		assert.Equal(t, map[string]*FlagStats{"Gost: x": {Pickups: 2, Captures: 1}, "Gost": {Returns: 1}}, game.CTF.Players)
+		assert.Equal(t, map[string]*FlagStats{TeamRed: {Pickups: 2, Captures: 1}, TeamBlue: {Returns: 1}}, game.CTF.Teams)
+	}
+	if assert.Len(t, game.Chat, 1) {
+		assert.Equal(t, Message{Time: 3, Player: "Gost", Message: "x: nice"}, game.Chat[0])
+	}
+}
+
+func TestParser_ParseLine_dialect(t *testing.T) {
+	lines := []string{
+		`  0:00 InitGame: \sv_hostname\Code Miner Server\g_gametype\0\mapname\q3dm17\gamename\baseq3`,
+		`  0:01 ClientUserinfoChanged: 2 n\Isgalamido\t\0\model\sarge`,
+		`  0:01 ClientUserinfoChanged: 3 n\Zeh\t\0\model\sarge`,
+		`  0:02 Kill: 2 3 23: Isgalamido killed Zeh by MOD_GRAPPLE`,
+	}
+
+	tests := []struct {
+		name    string
+		dialect Dialect
+		want    map[string]int
+	}{
+		{name: "Detected", want: map[string]int{"MOD_GRAPPLE": 1}},
+		{name: "Forced", dialect: OpenArena{}, want: map[string]int{"MOD_NAIL": 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Parser{Dialect: tt.dialect}
+			for _, line := range lines {
+				p.ParseLine(line)
+			}
+			p.End()
+
+			assert.Equal(t, tt.want, p.log["game_01"].KillsByMeans)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package parser
+
+// Event is a game event, as sent to Parser.OnEvent. Times are in seconds of game time.
+type Event struct {
+	Type   string `json:"type"`
+	Game   string `json:"game"`
+	Time   int    `json:"time"`
+	Player string `json:"player,omitempty"`
+	Killer string `json:"killer,omitempty"`
+	Victim string `json:"victim,omitempty"`
+	Means  string `json:"means,omitempty"`
+}
+
+const (
+	EventGameStart = "game_start"
+	EventGameEnd   = "game_end"
+	EventJoin      = "join"
+	EventLeave     = "leave"
+	EventKill      = "kill"
+)
+
+// Involves reports whether the player takes part in the event.
+func (e Event) Involves(player string) bool {
+	return e.Player == player || e.Killer == player || e.Victim == player
+}
+
+// emit sends the event to the aggregators, then to OnEvent, filling in the current game and,
+// unless set, the line time.
+func (p *Parser) emit(event Event) {
+	event.Game = p.gameKey()
+	if event.Time == 0 {
+		event.Time = p.timestamp()
+	}
+	p.aggregate(event)
+
+	if p.OnEvent != nil {
+		p.OnEvent(event)
+	}
+}
@@ -0,0 +1,89 @@
+//go:build unit
+
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParser_OnEvent(t *testing.T) {
+	log := `  0:00 InitGame: \sv_floodProtect\1\g_gametype\0\mapname\q3dm17
+ 20:34 ClientConnect: 2
+ 20:34 ClientUserinfoChanged: 2 n\Isgalamido\t\0\model\xian/default
+ 20:35 ClientConnect: 3
+ 20:35 ClientUserinfoChanged: 3 n\Zeh\t\0\model\sarge
+ 20:54 Kill: 2 3 6: Isgalamido killed Zeh by MOD_ROCKET
+ 21:10 ClientDisconnect: 3
+ 21:15 ShutdownGame:
+`
+
+	events := make([]Event, 0)
+	p := Parser{OnEvent: func(event Event) { events = append(events, event) }}
+	_, err := p.ParseReader(strings.NewReader(log))
+	assert.NoError(t, err)
+
+	want := []Event{
+		{Type: EventGameStart, Game: "game_01"},
+		{Type: EventJoin, Game: "game_01", Time: 1234, Player: "Isgalamido"},
+		{Type: EventJoin, Game: "game_01", Time: 1235, Player: "Zeh"},
+		{Type: EventKill, Game: "game_01", Time: 1254, Killer: "Isgalamido", Victim: "Zeh", Means: "MOD_ROCKET"},
+		{Type: EventLeave, Game: "game_01", Time: 1270, Player: "Zeh"},
+		{Type: EventLeave, Game: "game_01", Time: 1275, Player: "Isgalamido"},
+		{Type: EventGameEnd, Game: "game_01", Time: 1275},
+	}
+	assert.Equal(t, want, events)
+}
+
+func TestParser_ParseLine(t *testing.T) {
+	p := Parser{}
+	p.ParseLine(`  0:00 InitGame: \sv_floodProtect\1\g_gametype\0\mapname\q3dm17`)
+	p.ParseLine(` 20:54 Kill: 1022 2 22: <world> killed Isgalamido by MOD_TRIGGER_HURT`)
+	assert.Equal(t, 1, p.Games()["game_01"].TotalKills)
+
+	p.ParseLine(`  0:00 InitGame: \sv_floodProtect\1\g_gametype\0\mapname\q3dm17`)
+	assert.Len(t, p.Games(), 2)
+}
+
+func TestParser_End(t *testing.T) {
+	var events []Event
+	p := Parser{OnEvent: func(event Event) { events = append(events, event) }}
+	p.End()
+	assert.Empty(t, events)
+
+	p.ParseLine(`  0:00 InitGame: \sv_floodProtect\1\g_gametype\0\mapname\q3dm17`)
+	p.ParseLine(`  0:05 ClientConnect: 2`)
+	p.ParseLine(`  0:05 ClientUserinfoChanged: 2 n\Isgalamido\t\0\model\xian/default`)
+	p.ParseLine(`  1:10 Item: 2 weapon_rocketlauncher`)
+	p.End()
+	p.End()
+
+	assert.Equal(t, &Connection{JoinedAt: 5, LeftAt: 70, TimeOnServer: 65, PresentAtEnd: true}, p.Games()["game_01"].Connections["Isgalamido"])
+	assert.Equal(t, []Event{
+		{Type: EventGameStart, Game: "game_01"},
+		{Type: EventJoin, Game: "game_01", Time: 5, Player: "Isgalamido"},
+		{Type: EventLeave, Game: "game_01", Time: 70, Player: "Isgalamido"},
+		{Type: EventGameEnd, Game: "game_01", Time: 70},
+	}, events)
+}
+
+func TestEvent_Involves(t *testing.T) {
+	tests := []struct {
+		name   string
+		event  Event
+		player string
+		want   bool
+	}{
+		{name: "Player", event: Event{Type: EventJoin, Player: "Zeh"}, player: "Zeh", want: true},
+		{name: "Killer", event: Event{Type: EventKill, Killer: "Zeh", Victim: "Mal"}, player: "Zeh", want: true},
+		{name: "Victim", event: Event{Type: EventKill, Killer: "Mal", Victim: "Zeh"}, player: "Zeh", want: true},
+		{name: "Someone else", event: Event{Type: EventKill, Killer: "Mal", Victim: "Isgalamido"}, player: "Zeh", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.event.Involves(tt.player))
+		})
+	}
+}
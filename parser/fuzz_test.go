@@ -0,0 +1,103 @@
+//go:build unit
+
+package parser
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+)
+
+// seedLog is the log whose lines, and whose games, seed the fuzz corpora.
+const seedLog = "../qgames.log"
+
+func addSeedLines(f *testing.F) {
+	file, err := os.Open(seedLog)
+	if err != nil {
+		f.Fatal(err)
+	}
+	defer file.Close()
+
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// Lines only differing by their time are the same seed.
+		line := scanner.Text()
+		if key := strings.TrimLeft(line, " 0123456789:"); !seen[key] {
+			seen[key] = true
+			f.Add(line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		f.Fatal(err)
+	}
+}
+
+func addSeedGames(f *testing.F) {
+	content, err := os.ReadFile(seedLog)
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	games := strings.SplitAfter(string(content), "ShutdownGame:\n")
+	for _, game := range games {
+		f.Add(game)
+	}
+	f.Add(string(content))
+}
+
+// checkGames checks the invariants of the parsed games: the kills by means of death add up to
+// the total kills, and every player scored in kills and the kill matrix is a player of the game.
+func checkGames(t *testing.T, games map[string]Game) {
+	t.Helper()
+	for key, game := range games {
+		byMeans := 0
+		for _, kills := range game.KillsByMeans {
+			byMeans += kills
+		}
+		if byMeans != game.TotalKills {
+			t.Errorf("%s: total_kills %d, kills_by_means adding up to %d", key, game.TotalKills, byMeans)
+		}
+
+		for player := range game.Kills {
+			if !playedBy(game, player) {
+				t.Errorf("%s: %q scored in kills but is not a player", key, player)
+			}
+		}
+		for killer := range game.KillMatrix {
+			if !playedBy(game, killer) {
+				t.Errorf("%s: %q killed but is not a player", key, killer)
+			}
+		}
+	}
+}
+
+func FuzzParser_ParseLine(f *testing.F) {
+	addSeedLines(f)
+
+	f.Fuzz(func(t *testing.T, line string) {
+		p := Parser{}
+		p.ParseLine(`  0:00 InitGame: \sv_hostname\Code Miner Server\g_gametype\0\mapname\q3dm17\gamename\baseq3`)
+		p.ParseLine(`  0:01 ClientUserinfoChanged: 2 n\Isgalamido\t\0\model\sarge`)
+		p.ParseLine(`  0:01 ClientUserinfoChanged: 3 n\Zeh\t\0\model\sarge`)
+		p.ParseLine(line)
+		p.End()
+
+		checkGames(t, p.Games())
+	})
+}
+
+func FuzzParser_ParseReader(f *testing.F) {
+	addSeedGames(f)
+
+	f.Fuzz(func(t *testing.T, log string) {
+		p := Parser{}
+		games, err := p.ParseReader(strings.NewReader(log))
+		if err != nil {
+			return
+		}
+
+		checkGames(t, games)
+	})
+}
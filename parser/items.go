@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	ItemWeapon   = "weapon"
+	ItemAmmo     = "ammo"
+	ItemArmor    = "armor"
+	ItemHealth   = "health"
+	ItemPowerup  = "powerup"
+	ItemHoldable = "holdable"
+)
+
+var itemLine = regexp.MustCompile(`Item: (\d+) (\w+)`)
+
+var powerups = map[string]bool{
+	"item_quad":   true,
+	"item_enviro": true,
+	"item_haste":  true,
+	"item_invis":  true,
+	"item_regen":  true,
+	"item_flight": true,
+}
+
+// addItem counts the item pickups of the game and of the player picking them up, by category.
+// Flags are handled by addFlag, which runs first.
+func (p *Parser) addItem() bool {
+	if p.errorState || !strings.Contains(p.line, "Item:") {
+		return false
+	}
+
+	matches := itemLine.FindStringSubmatch(p.line)
+	if len(matches) < 3 {
+		p.errorState = true
+		return true
+	}
+
+	category := itemCategory(matches[2])
+	if category == "" {
+		return true
+	}
+
+	game := p.log[p.gameKey()]
+	game.ItemsByCategory[category]++
+
+	player, ok := p.clients[matches[1]]
+	if !ok {
+		return true
+	}
+	if _, ok := game.ItemsByPlayer[player]; !ok {
+		game.ItemsByPlayer[player] = make(map[string]int)
+	}
+	game.ItemsByPlayer[player][category]++
+	return true
+}
+
+func itemCategory(item string) string {
+	switch {
+	case strings.HasPrefix(item, "weapon_"):
+		return ItemWeapon
+	case strings.HasPrefix(item, "ammo_"):
+		return ItemAmmo
+	case strings.HasPrefix(item, "item_armor_"):
+		return ItemArmor
+	case strings.HasPrefix(item, "item_health"):
+		return ItemHealth
+	case powerups[item]:
+		return ItemPowerup
+	case strings.HasPrefix(item, "holdable_"):
+		return ItemHoldable
+	}
+	return ""
+}
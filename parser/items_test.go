@@ -0,0 +1,179 @@
+//go:build unit
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParser_addItem(t *testing.T) {
+	tests := []struct {
+		name        string
+		fields      Parser
+		want        Parser
+		expectedRes bool
+	}{
+		{
+			name:        "Not Item line",
+			fields:      Parser{},
+			want:        Parser{},
+			expectedRes: false,
+		},
+		{
+			name: "Game in error state",
+			fields: Parser{
+				line:        " 20:40 Item: 2 weapon_rocketlauncher",
+				errorState:  true,
+				gameCounter: 0,
+				log:         make(map[string]Game),
+			},
+			want: Parser{
+				line:        " 20:40 Item: 2 weapon_rocketlauncher",
+				errorState:  true,
+				gameCounter: 0,
+				log:         make(map[string]Game),
+			},
+			expectedRes: false,
+		},
+		{
+			name: "Error in regex",
+			fields: Parser{
+				line:        " 20:40 Item: weapon_rocketlauncher",
+				errorState:  false,
+				gameCounter: 0,
+				log:         make(map[string]Game),
+			},
+			want: Parser{
+				line:        " 20:40 Item: weapon_rocketlauncher",
+				errorState:  true,
+				gameCounter: 0,
+				log:         make(map[string]Game),
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Unknown item",
+			fields: Parser{
+				line:        " 20:40 Item: 2 item_unknown",
+				gameCounter: 1,
+				clients:     map[string]string{"2": "Isgalamido"},
+				log: map[string]Game{
+					"game_01": {
+						ItemsByCategory: make(map[string]int),
+						ItemsByPlayer:   make(map[string]map[string]int),
+					},
+				},
+			},
+			want: Parser{
+				line:        " 20:40 Item: 2 item_unknown",
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						ItemsByCategory: make(map[string]int),
+						ItemsByPlayer:   make(map[string]map[string]int),
+					},
+				},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Unknown client",
+			fields: Parser{
+				line:        " 20:40 Item: 3 weapon_rocketlauncher",
+				gameCounter: 1,
+				clients:     map[string]string{"2": "Isgalamido"},
+				log: map[string]Game{
+					"game_01": {
+						ItemsByCategory: make(map[string]int),
+						ItemsByPlayer:   make(map[string]map[string]int),
+					},
+				},
+			},
+			want: Parser{
+				line:        " 20:40 Item: 3 weapon_rocketlauncher",
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						ItemsByCategory: map[string]int{
+							ItemWeapon: 1,
+						},
+						ItemsByPlayer: make(map[string]map[string]int),
+					},
+				},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Success",
+			fields: Parser{
+				line:        " 20:42 Item: 2 item_armor_body",
+				gameCounter: 1,
+				clients:     map[string]string{"2": "Isgalamido"},
+				log: map[string]Game{
+					"game_01": {
+						ItemsByCategory: map[string]int{
+							ItemWeapon: 1,
+						},
+						ItemsByPlayer: map[string]map[string]int{
+							"Isgalamido": {
+								ItemWeapon: 1,
+							},
+						},
+					},
+				},
+			},
+			want: Parser{
+				line:        " 20:42 Item: 2 item_armor_body",
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						ItemsByCategory: map[string]int{
+							ItemWeapon: 1,
+							ItemArmor:  1,
+						},
+						ItemsByPlayer: map[string]map[string]int{
+							"Isgalamido": {
+								ItemWeapon: 1,
+								ItemArmor:  1,
+							},
+						},
+					},
+				},
+			},
+			expectedRes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.fields.addItem()
+			assert.Equal(t, tt.expectedRes, res)
+			assert.Equal(t, tt.want.line, tt.fields.line)
+			assert.Equal(t, tt.want.errorState, tt.fields.errorState)
+			assert.Equal(t, tt.want.gameCounter, tt.fields.gameCounter)
+			assert.Equal(t, tt.want.log, tt.fields.log)
+		})
+	}
+}
+
+func Test_itemCategory(t *testing.T) {
+	tests := []struct {
+		item string
+		want string
+	}{
+		{item: "weapon_rocketlauncher", want: ItemWeapon},
+		{item: "ammo_shells", want: ItemAmmo},
+		{item: "item_armor_shard", want: ItemArmor},
+		{item: "item_health_mega", want: ItemHealth},
+		{item: "item_health", want: ItemHealth},
+		{item: "item_quad", want: ItemPowerup},
+		{item: "holdable_medkit", want: ItemHoldable},
+		{item: "team_CTF_redflag", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.item, func(t *testing.T) {
+			assert.Equal(t, tt.want, itemCategory(tt.item))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Inconsistency is a Kill line whose text disagrees with its numeric IDs, the names of the IDs being kept.
+type Inconsistency struct {
+	Time     int    `json:"time"`
+	Logged   string `json:"logged"`
+	Resolved string `json:"resolved"`
+}
+
+const (
+	// worldID is the killer ID of the deaths caused by the world.
+	worldID = "1022"
+	// baseq3GrappleID is the ID of MOD_GRAPPLE in the builds without the Team Arena means of death.
+	baseq3GrappleID = 23
+)
+
+// resolveKill names the killer and victim of a decoded Kill line from their IDs, by the names the
+// clients currently use. The text of the line is only trusted for the IDs unknown, the players it
+// names joining the players of the game, and is otherwise checked against the names resolved, as logged.
+func (p *Parser) resolveKill(kill Line) (killer, victim, means string, ok bool) {
+	killer, victim, means = p.playerName(kill.Killer), p.playerName(kill.Victim), kill.Means
+	killerKnown, victimKnown := kill.KillerID == worldID, false
+	if kill.KillerID == worldID {
+		killer = "<world>"
+	} else if name, ok := p.clients[kill.KillerID]; ok {
+		killer, killerKnown = name, true
+	}
+	if name, ok := p.clients[kill.VictimID]; ok {
+		victim, victimKnown = name, true
+	}
+
+	if killer == "" || victim == "" || means == "" {
+		return "", "", "", false
+	}
+	if !killerKnown {
+		p.addGamePlayer(killer)
+	}
+	if !victimKnown {
+		p.addGamePlayer(victim)
+	}
+
+	resolved := fmt.Sprintf("%s killed %s by %s", p.displayName(killer), p.displayName(victim), means)
+	if text := strings.TrimRightFunc(kill.Text, unicode.IsSpace); text != resolved {
+		p.addInconsistency(kill.Text, resolved)
+	}
+	return killer, victim, means, true
+}
+
+// meansName returns the name of the means of death of the ID, or the logged one when the ID is not of ioq3.
+func meansName(id, logged string) string {
+	means, err := strconv.Atoi(id)
+	if err != nil || !MeansOfDeath(means).Valid() {
+		return logged
+	}
+	return MeansOfDeath(means).String()
+}
+
+func (p *Parser) addInconsistency(logged, resolved string) {
+	game, ok := p.log[p.gameKey()]
+	if !ok {
+		return
+	}
+
+	game.Inconsistencies = append(game.Inconsistencies, Inconsistency{Time: p.timestamp(), Logged: logged, Resolved: resolved})
+	p.log[p.gameKey()] = game
+}
@@ -0,0 +1,127 @@
+//go:build unit
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParser_resolveKill(t *testing.T) {
+	tests := []struct {
+		name                string
+		clients             map[string]string
+		killerID            string
+		victimID            string
+		meansID             string
+		text                string
+		wantKiller          string
+		wantVictim          string
+		wantMeans           string
+		wantOk              bool
+		wantInconsistencies []Inconsistency
+		wantPlayers         []string
+	}{
+		{
+			name:        "Unknown IDs trust the text",
+			killerID:    "3",
+			victimID:    "2",
+			meansID:     "6",
+			text:        "Isgalamido killed Dono da Bola by MOD_ROCKET",
+			wantKiller:  "Isgalamido",
+			wantVictim:  "Dono da Bola",
+			wantMeans:   "MOD_ROCKET",
+			wantOk:      true,
+			wantPlayers: []string{"Isgalamido", "Dono da Bola"},
+		},
+		{
+			name:       "World",
+			clients:    map[string]string{"2": "Isgalamido"},
+			killerID:   "1022",
+			victimID:   "2",
+			meansID:    "22",
+			text:       "<world> killed Isgalamido by MOD_TRIGGER_HURT",
+			wantKiller: "<world>",
+			wantVictim: "Isgalamido",
+			wantMeans:  "MOD_TRIGGER_HURT",
+			wantOk:     true,
+		},
+		{
+			name:       "Names with killed and by",
+			clients:    map[string]string{"2": "killed by", "3": "Zeh killed"},
+			killerID:   "3",
+			victimID:   "2",
+			meansID:    "10",
+			text:       "Zeh killed killed killed by by MOD_RAILGUN",
+			wantKiller: "Zeh killed",
+			wantVictim: "killed by",
+			wantMeans:  "MOD_RAILGUN",
+			wantOk:     true,
+		},
+		{
+			name:                "Truncated text",
+			clients:             map[string]string{"2": "Isgalamido"},
+			killerID:            "1022",
+			victimID:            "2",
+			meansID:             "19",
+			text:                "<world> killed Isgalamido by",
+			wantKiller:          "<world>",
+			wantVictim:          "Isgalamido",
+			wantMeans:           "MOD_FALLING",
+			wantOk:              true,
+			wantInconsistencies: []Inconsistency{{Time: 60, Logged: "<world> killed Isgalamido by", Resolved: "<world> killed Isgalamido by MOD_FALLING"}},
+		},
+		{
+			name:       "Grapple of baseq3",
+			clients:    map[string]string{"2": "Isgalamido", "3": "Zeh"},
+			killerID:   "3",
+			victimID:   "2",
+			meansID:    "23",
+			text:       "Zeh killed Isgalamido by MOD_GRAPPLE",
+			wantKiller: "Zeh",
+			wantVictim: "Isgalamido",
+			wantMeans:  "MOD_GRAPPLE",
+			wantOk:     true,
+		},
+		{
+			name:       "Means of death of a mod",
+			clients:    map[string]string{"2": "Isgalamido", "3": "Zeh"},
+			killerID:   "3",
+			victimID:   "2",
+			meansID:    "99",
+			text:       "Zeh killed Isgalamido by MOD_CUSTOM",
+			wantKiller: "Zeh",
+			wantVictim: "Isgalamido",
+			wantMeans:  "MOD_CUSTOM",
+			wantOk:     true,
+		},
+		{
+			name:     "Unknown IDs and unreadable text",
+			killerID: "3",
+			victimID: "2",
+			meansID:  "6",
+			text:     "Isgalamido",
+			wantOk:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Parser{
+				line:        "  1:00 Kill: " + tt.killerID + " " + tt.victimID + " " + tt.meansID + ": " + tt.text,
+				gameCounter: 1,
+				clients:     tt.clients,
+				log:         map[string]Game{"game_01": {}},
+			}
+			kill, decoded := Baseq3{}.Decode(LineKill, p.line)
+			assert.True(t, decoded)
+			killer, victim, means, ok := p.resolveKill(kill)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantKiller, killer)
+			assert.Equal(t, tt.wantVictim, victim)
+			assert.Equal(t, tt.wantMeans, means)
+			assert.Equal(t, tt.wantInconsistencies, p.log["game_01"].Inconsistencies)
+			assert.Equal(t, tt.wantPlayers, p.log["game_01"].Players)
+		})
+	}
+}
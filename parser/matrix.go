@@ -0,0 +1,82 @@
+package parser
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// KillMatrix counts the kills between players, by killer then by victim. Suicides are on
+// the diagonal, deaths by the world are left out.
+type KillMatrix map[string]map[string]int
+
+// addMatrixKill counts the kill in the kill matrix of the game.
+func (p *Parser) addMatrixKill(killer, victim string) {
+	matrix := p.log[p.gameKey()].KillMatrix
+	if matrix == nil || killer == "<world>" {
+		return
+	}
+	matrix.add(killer, victim, 1)
+}
+
+func (m KillMatrix) add(killer, victim string, kills int) {
+	if m[killer] == nil {
+		m[killer] = make(map[string]int)
+	}
+	m[killer][victim] += kills
+}
+
+// OverallKillMatrix sums the kill matrices of the games.
+func OverallKillMatrix(games map[string]Game) KillMatrix {
+	matrix := make(KillMatrix)
+	for _, game := range games {
+		for killer, victims := range game.KillMatrix {
+			for victim, kills := range victims {
+				matrix.add(killer, victim, kills)
+			}
+		}
+	}
+	return matrix
+}
+
+// Players returns the killers and victims of the matrix, by name.
+func (m KillMatrix) Players() []string {
+	seen := make(map[string]bool)
+	for killer, victims := range m {
+		seen[killer] = true
+		for victim := range victims {
+			seen[victim] = true
+		}
+	}
+
+	players := make([]string, 0, len(seen))
+	for player := range seen {
+		players = append(players, player)
+	}
+	sort.Strings(players)
+	return players
+}
+
+// WriteCSV writes the matrix as a square table, a row per killer and a column per victim,
+// every player being both, ready for a spreadsheet heatmap.
+func (m KillMatrix) WriteCSV(w io.Writer) error {
+	players := m.Players()
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(append([]string{`killer\victim`}, players...)); err != nil {
+		return err
+	}
+	for _, killer := range players {
+		row := make([]string, 0, len(players)+1)
+		row = append(row, killer)
+		for _, victim := range players {
+			row = append(row, strconv.Itoa(m[killer][victim]))
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
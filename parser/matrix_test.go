@@ -0,0 +1,107 @@
+//go:build unit
+
+package parser
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParser_addMatrixKill(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields Parser
+		killer string
+		victim string
+		want   KillMatrix
+	}{
+		{
+			name:   "Player kill",
+			fields: Parser{gameCounter: 1, log: map[string]Game{"game_01": {KillMatrix: KillMatrix{"Zeh": {"Mal": 1}}}}},
+			killer: "Zeh",
+			victim: "Mal",
+			want:   KillMatrix{"Zeh": {"Mal": 2}},
+		},
+		{
+			name:   "Suicide",
+			fields: Parser{gameCounter: 1, log: map[string]Game{"game_01": {KillMatrix: KillMatrix{}}}},
+			killer: "Zeh",
+			victim: "Zeh",
+			want:   KillMatrix{"Zeh": {"Zeh": 1}},
+		},
+		{
+			name:   "World kill",
+			fields: Parser{gameCounter: 1, log: map[string]Game{"game_01": {KillMatrix: KillMatrix{}}}},
+			killer: "<world>",
+			victim: "Zeh",
+			want:   KillMatrix{},
+		},
+		{
+			name:   "Game without matrix",
+			fields: Parser{gameCounter: 1, log: map[string]Game{"game_01": {}}},
+			killer: "Zeh",
+			victim: "Mal",
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fields.addMatrixKill(tt.killer, tt.victim)
+			assert.Equal(t, tt.want, tt.fields.log["game_01"].KillMatrix)
+		})
+	}
+}
+
+func TestOverallKillMatrix(t *testing.T) {
+	games := map[string]Game{
+		"game_01": {KillMatrix: KillMatrix{"Zeh": {"Mal": 2}, "Mal": {"Zeh": 1}}},
+		"game_02": {KillMatrix: KillMatrix{"Zeh": {"Mal": 1, "Isgalamido": 3}}},
+		"game_03": {},
+	}
+	assert.Equal(t, KillMatrix{"Zeh": {"Mal": 3, "Isgalamido": 3}, "Mal": {"Zeh": 1}}, OverallKillMatrix(games))
+	assert.Equal(t, KillMatrix{}, OverallKillMatrix(nil))
+}
+
+func TestKillMatrix_Players(t *testing.T) {
+	matrix := KillMatrix{"Zeh": {"Mal": 3, "Isgalamido": 3}, "Mal": {"Dono da Bola": 1}}
+	assert.Equal(t, []string{"Dono da Bola", "Isgalamido", "Mal", "Zeh"}, matrix.Players())
+	assert.Equal(t, []string{}, KillMatrix{}.Players())
+}
+
+func TestKillMatrix_WriteCSV(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix KillMatrix
+		want   string
+	}{
+		{
+			name:   "Empty",
+			matrix: KillMatrix{},
+			want:   "killer\\victim\n",
+		},
+		{
+			name:   "Square",
+			matrix: KillMatrix{"Zeh": {"Mal": 3, "Zeh": 1}, "Mal": {"Dono da Bola": 1}},
+			want: "killer\\victim,Dono da Bola,Mal,Zeh\n" +
+				"Dono da Bola,0,0,0\n" +
+				"Mal,1,0,0\n" +
+				"Zeh,0,3,1\n",
+		},
+		{
+			name:   "Quoted names",
+			matrix: KillMatrix{`Chessus, "the" best`: {"Mal": 1}},
+			want: "killer\\victim,\"Chessus, \"\"the\"\" best\",Mal\n" +
+				"\"Chessus, \"\"the\"\" best\",0,1\n" +
+				"Mal,0,0\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			assert.NoError(t, tt.matrix.WriteCSV(&buf))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// MeansOfDeath is a means of death of ioq3, its value being the numeric ID of the Kill lines.
+type MeansOfDeath int
+
+// The means of death of ioq3, in the order of meansOfDeath_t. MOD_NAIL to MOD_JUICED only exist
+// in Team Arena builds: other builds log MOD_GRAPPLE as 23.
+const (
+	ModUnknown MeansOfDeath = iota
+	ModShotgun
+	ModGauntlet
+	ModMachinegun
+	ModGrenade
+	ModGrenadeSplash
+	ModRocket
+	ModRocketSplash
+	ModPlasma
+	ModPlasmaSplash
+	ModRailgun
+	ModLightning
+	ModBFG
+	ModBFGSplash
+	ModWater
+	ModSlime
+	ModLava
+	ModCrush
+	ModTelefrag
+	ModFalling
+	ModSuicide
+	ModTargetLaser
+	ModTriggerHurt
+	ModNail
+	ModChaingun
+	ModProximityMine
+	ModKamikaze
+	ModJuiced
+	ModGrapple
+)
+
+// Weapon families, grouping the direct and splash means of death of a weapon.
+const (
+	FamilyUnknown      = "unknown"
+	FamilyShotgun      = "shotgun"
+	FamilyGauntlet     = "gauntlet"
+	FamilyMachinegun   = "machinegun"
+	FamilyGrenade      = "grenade_launcher"
+	FamilyRocket       = "rocket_launcher"
+	FamilyPlasma       = "plasmagun"
+	FamilyRailgun      = "railgun"
+	FamilyLightning    = "lightning"
+	FamilyBFG          = "bfg"
+	FamilyNailgun      = "nailgun"
+	FamilyChaingun     = "chaingun"
+	FamilyProxLauncher = "prox_launcher"
+	FamilyKamikaze     = "kamikaze"
+	FamilyGrapple      = "grapple"
+	FamilyTelefrag     = "telefrag"
+	FamilySuicide      = "suicide"
+	// FamilyEnvironment groups the deaths caused by the map rather than by a player.
+	FamilyEnvironment = "environment"
+)
+
+type meansInfo struct {
+	name          string
+	family        string
+	splash        bool
+	environmental bool
+}
+
+var meansOfDeath = []meansInfo{
+	ModUnknown:       {name: "MOD_UNKNOWN", family: FamilyUnknown},
+	ModShotgun:       {name: "MOD_SHOTGUN", family: FamilyShotgun},
+	ModGauntlet:      {name: "MOD_GAUNTLET", family: FamilyGauntlet},
+	ModMachinegun:    {name: "MOD_MACHINEGUN", family: FamilyMachinegun},
+	ModGrenade:       {name: "MOD_GRENADE", family: FamilyGrenade},
+	ModGrenadeSplash: {name: "MOD_GRENADE_SPLASH", family: FamilyGrenade, splash: true},
+	ModRocket:        {name: "MOD_ROCKET", family: FamilyRocket},
+	ModRocketSplash:  {name: "MOD_ROCKET_SPLASH", family: FamilyRocket, splash: true},
+	ModPlasma:        {name: "MOD_PLASMA", family: FamilyPlasma},
+	ModPlasmaSplash:  {name: "MOD_PLASMA_SPLASH", family: FamilyPlasma, splash: true},
+	ModRailgun:       {name: "MOD_RAILGUN", family: FamilyRailgun},
+	ModLightning:     {name: "MOD_LIGHTNING", family: FamilyLightning},
+	ModBFG:           {name: "MOD_BFG", family: FamilyBFG},
+	ModBFGSplash:     {name: "MOD_BFG_SPLASH", family: FamilyBFG, splash: true},
+	ModWater:         {name: "MOD_WATER", family: FamilyEnvironment, environmental: true},
+	ModSlime:         {name: "MOD_SLIME", family: FamilyEnvironment, environmental: true},
+	ModLava:          {name: "MOD_LAVA", family: FamilyEnvironment, environmental: true},
+	ModCrush:         {name: "MOD_CRUSH", family: FamilyEnvironment, environmental: true},
+	ModTelefrag:      {name: "MOD_TELEFRAG", family: FamilyTelefrag},
+	ModFalling:       {name: "MOD_FALLING", family: FamilyEnvironment, environmental: true},
+	ModSuicide:       {name: "MOD_SUICIDE", family: FamilySuicide},
+	ModTargetLaser:   {name: "MOD_TARGET_LASER", family: FamilyEnvironment, environmental: true},
+	ModTriggerHurt:   {name: "MOD_TRIGGER_HURT", family: FamilyEnvironment, environmental: true},
+	ModNail:          {name: "MOD_NAIL", family: FamilyNailgun},
+	ModChaingun:      {name: "MOD_CHAINGUN", family: FamilyChaingun},
+	ModProximityMine: {name: "MOD_PROXIMITY_MINE", family: FamilyProxLauncher, splash: true},
+	ModKamikaze:      {name: "MOD_KAMIKAZE", family: FamilyKamikaze, splash: true},
+	ModJuiced:        {name: "MOD_JUICED", family: FamilyProxLauncher, splash: true},
+	ModGrapple:       {name: "MOD_GRAPPLE", family: FamilyGrapple},
+}
+
+var meansByName = func() map[string]MeansOfDeath {
+	byName := make(map[string]MeansOfDeath, len(meansOfDeath))
+	for id, info := range meansOfDeath {
+		byName[info.name] = MeansOfDeath(id)
+	}
+	return byName
+}()
+
+// ParseMeansOfDeath returns the means of death named as in the Kill lines, e.g. MOD_ROCKET.
+func ParseMeansOfDeath(name string) (MeansOfDeath, bool) {
+	means, ok := meansByName[name]
+	return means, ok
+}
+
+// Valid reports whether the means of death is one of ioq3.
+func (m MeansOfDeath) Valid() bool {
+	return m >= 0 && int(m) < len(meansOfDeath)
+}
+
+func (m MeansOfDeath) String() string {
+	if !m.Valid() {
+		return "MOD_" + strconv.Itoa(int(m))
+	}
+	return meansOfDeath[m].name
+}
+
+// Family returns the weapon family of the means of death, e.g. rocket_launcher for MOD_ROCKET_SPLASH.
+func (m MeansOfDeath) Family() string {
+	if !m.Valid() {
+		return FamilyUnknown
+	}
+	return meansOfDeath[m].family
+}
+
+// Splash reports whether the means of death is the blast of an explosion rather than a direct hit.
+func (m MeansOfDeath) Splash() bool {
+	return m.Valid() && meansOfDeath[m].splash
+}
+
+// Environmental reports whether the means of death is caused by the map rather than by a player.
+func (m MeansOfDeath) Environmental() bool {
+	return m.Valid() && meansOfDeath[m].environmental
+}
+
+func (m MeansOfDeath) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *MeansOfDeath) UnmarshalText(text []byte) error {
+	means, ok := ParseMeansOfDeath(string(text))
+	if !ok {
+		return fmt.Errorf("unknown means of death %q", text)
+	}
+	*m = means
+	return nil
+}
+
+// MeansFamily returns the weapon family of the means of death named as in the Kill lines.
+// Means of death unknown to ioq3, e.g. of mods, are their own family.
+func MeansFamily(name string) string {
+	means, ok := ParseMeansOfDeath(name)
+	if !ok {
+		return name
+	}
+	return means.Family()
+}
+
+// KillsByFamily sums kills by means of death, as in Game.KillsByMeans, by weapon family.
+func KillsByFamily(killsByMeans map[string]int) map[string]int {
+	byFamily := make(map[string]int)
+	for name, kills := range killsByMeans {
+		byFamily[MeansFamily(name)] += kills
+	}
+	return byFamily
+}
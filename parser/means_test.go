@@ -0,0 +1,81 @@
+//go:build unit
+
+package parser
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeansOfDeath(t *testing.T) {
+	tests := []struct {
+		name              string
+		means             MeansOfDeath
+		wantID            int
+		wantString        string
+		wantFamily        string
+		wantSplash        bool
+		wantEnvironmental bool
+	}{
+		{name: "Rocket", means: ModRocket, wantID: 6, wantString: "MOD_ROCKET", wantFamily: FamilyRocket},
+		{name: "Rocket splash", means: ModRocketSplash, wantID: 7, wantString: "MOD_ROCKET_SPLASH", wantFamily: FamilyRocket, wantSplash: true},
+		{name: "BFG splash", means: ModBFGSplash, wantID: 13, wantString: "MOD_BFG_SPLASH", wantFamily: FamilyBFG, wantSplash: true},
+		{name: "Railgun", means: ModRailgun, wantID: 10, wantString: "MOD_RAILGUN", wantFamily: FamilyRailgun},
+		{name: "Telefrag", means: ModTelefrag, wantID: 18, wantString: "MOD_TELEFRAG", wantFamily: FamilyTelefrag},
+		{name: "Falling", means: ModFalling, wantID: 19, wantString: "MOD_FALLING", wantFamily: FamilyEnvironment, wantEnvironmental: true},
+		{name: "Trigger hurt", means: ModTriggerHurt, wantID: 22, wantString: "MOD_TRIGGER_HURT", wantFamily: FamilyEnvironment, wantEnvironmental: true},
+		{name: "Grapple", means: ModGrapple, wantID: 28, wantString: "MOD_GRAPPLE", wantFamily: FamilyGrapple},
+		{name: "Out of range", means: MeansOfDeath(99), wantID: 99, wantString: "MOD_99", wantFamily: FamilyUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantID, int(tt.means))
+			assert.Equal(t, tt.wantString, tt.means.String())
+			assert.Equal(t, tt.wantFamily, tt.means.Family())
+			assert.Equal(t, tt.wantSplash, tt.means.Splash())
+			assert.Equal(t, tt.wantEnvironmental, tt.means.Environmental())
+		})
+	}
+}
+
+func TestParseMeansOfDeath(t *testing.T) {
+	for id := ModUnknown; id <= ModGrapple; id++ {
+		means, ok := ParseMeansOfDeath(id.String())
+		assert.True(t, ok)
+		assert.Equal(t, id, means)
+	}
+
+	_, ok := ParseMeansOfDeath("MOD_NOPE")
+	assert.False(t, ok)
+}
+
+func TestMeansOfDeath_JSON(t *testing.T) {
+	out, err := json.Marshal(map[MeansOfDeath]int{ModRocket: 2, ModRailgun: 1})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"MOD_ROCKET":2,"MOD_RAILGUN":1}`, string(out))
+
+	var in map[MeansOfDeath]int
+	assert.NoError(t, json.Unmarshal(out, &in))
+	assert.Equal(t, map[MeansOfDeath]int{ModRocket: 2, ModRailgun: 1}, in)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"MOD_NOPE":1}`), &in))
+}
+
+func TestKillsByFamily(t *testing.T) {
+	killsByMeans := map[string]int{
+		"MOD_ROCKET":        3,
+		"MOD_ROCKET_SPLASH": 4,
+		"MOD_FALLING":       1,
+		"MOD_TRIGGER_HURT":  2,
+		"MOD_RAILGUN":       5,
+		"MOD_CUSTOM":        1,
+	}
+	assert.Equal(t, map[string]int{
+		FamilyRocket:      7,
+		FamilyEnvironment: 3,
+		FamilyRailgun:     5,
+		"MOD_CUSTOM":      1,
+	}, KillsByFamily(killsByMeans))
+}
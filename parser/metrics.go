@@ -0,0 +1,120 @@
+package parser
+
+import (
+	"regexp"
+	"sync"
+	"time"
+)
+
+type (
+	// Metrics counts the activity of the parsers it is given to, it is safe for concurrent use.
+	// A nil *Metrics counts nothing.
+	Metrics struct {
+		mu       sync.Mutex
+		counters MetricsSnapshot
+	}
+
+	// MetricsSnapshot is a copy of the metrics at a point in time.
+	MetricsSnapshot struct {
+		Lines          int
+		LinesByType    map[string]int
+		MalformedLines int
+		Games          int
+		KillsByMeans   map[string]int
+		// ActivePlayers is the number of players connected to the game being parsed.
+		ActivePlayers int
+		ParseTime     time.Duration
+	}
+)
+
+const (
+	LineTypeSeparator = "separator"
+	LineTypeTeamScore = "TeamScore"
+	LineTypeOther     = "other"
+)
+
+var lineTypeRegexp = regexp.MustCompile(`^\s*\d+:\d{2} (?:([A-Za-z_]+):|(-+)$)`)
+
+func NewMetrics() *Metrics {
+	return &Metrics{counters: MetricsSnapshot{
+		LinesByType:  make(map[string]int),
+		KillsByMeans: make(map[string]int),
+	}}
+}
+
+func (m *Metrics) Snapshot() MetricsSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := m.counters
+	snapshot.LinesByType = make(map[string]int, len(m.counters.LinesByType))
+	for lineType, count := range m.counters.LinesByType {
+		snapshot.LinesByType[lineType] = count
+	}
+	snapshot.KillsByMeans = make(map[string]int, len(m.counters.KillsByMeans))
+	for means, count := range m.counters.KillsByMeans {
+		snapshot.KillsByMeans[means] = count
+	}
+	return snapshot
+}
+
+// observeLine counts a parsed line. Lines without the game time prefix are malformed, as are
+// those the parser failed to read.
+func (m *Metrics) observeLine(line string, elapsed time.Duration, failed bool, activePlayers int) {
+	if m == nil {
+		return
+	}
+
+	lineType, ok := LineType(line)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters.Lines++
+	m.counters.ParseTime += elapsed
+	m.counters.ActivePlayers = activePlayers
+	if ok {
+		m.counters.LinesByType[lineType]++
+	}
+	if failed || !ok {
+		m.counters.MalformedLines++
+	}
+}
+
+func (m *Metrics) addGame() {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters.Games++
+}
+
+func (m *Metrics) addKill(means string) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters.KillsByMeans[means]++
+}
+
+// LineType returns the event of a log line, such as Kill or InitGame, false if the line has no game time.
+func LineType(line string) (string, bool) {
+	matches := lineTypeRegexp.FindStringSubmatch(line)
+	if matches == nil {
+		if _, ok := parseTimestamp(line); ok {
+			return LineTypeOther, true
+		}
+		return "", false
+	}
+
+	if matches[2] != "" {
+		return LineTypeSeparator, true
+	}
+	if matches[1] == "red" {
+		return LineTypeTeamScore, true
+	}
+	return matches[1], true
+}
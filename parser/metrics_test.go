@@ -0,0 +1,86 @@
+//go:build unit
+
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParser_Metrics(t *testing.T) {
+	log := `  0:00 ------------------------------------------------------------
+  0:00 InitGame: \sv_floodProtect\1\g_gametype\0\mapname\q3dm17
+ 20:34 ClientConnect: 2
+ 20:34 ClientUserinfoChanged: 2 n\Isgalamido\t\0\model\xian/default
+ 20:35 ClientConnect: 3
+ 20:35 ClientUserinfoChanged: 3 n\Zeh\t\0\model\sarge
+ 20:54 Kill: 2 3 6: Isgalamido killed Zeh by MOD_ROCKET
+ 20:55 Kill: 1022 2 22: <world> killed Isgalamido by MOD_TRIGGER_HURT
+ 20:56 Kill: 1022 2 <world> killed Isgalamido by
+26  0:00 ------------------------------------------------------------
+`
+
+	p := Parser{Metrics: NewMetrics()}
+	_, err := p.ParseReader(strings.NewReader(log))
+	assert.NoError(t, err)
+
+	snapshot := p.Metrics.Snapshot()
+	assert.Equal(t, 10, snapshot.Lines)
+	assert.Equal(t, map[string]int{
+		LineTypeSeparator:       1,
+		"InitGame":              1,
+		"ClientConnect":         2,
+		"ClientUserinfoChanged": 2,
+		"Kill":                  3,
+	}, snapshot.LinesByType)
+	assert.Equal(t, 2, snapshot.MalformedLines)
+	assert.Equal(t, 1, snapshot.Games)
+	assert.Equal(t, map[string]int{"MOD_ROCKET": 1, "MOD_TRIGGER_HURT": 1}, snapshot.KillsByMeans)
+	assert.Equal(t, 2, snapshot.ActivePlayers)
+	assert.Positive(t, snapshot.ParseTime)
+}
+
+func TestMetrics_Snapshot(t *testing.T) {
+	m := NewMetrics()
+	m.addKill("MOD_ROCKET")
+
+	snapshot := m.Snapshot()
+	m.addKill("MOD_ROCKET")
+	assert.Equal(t, map[string]int{"MOD_ROCKET": 1}, snapshot.KillsByMeans)
+	assert.Equal(t, map[string]int{"MOD_ROCKET": 2}, m.Snapshot().KillsByMeans)
+}
+
+func TestMetrics_nil(t *testing.T) {
+	var m *Metrics
+	assert.NotPanics(t, func() {
+		m.observeLine(" 20:54 Kill: 2 3 6: Isgalamido killed Zeh by MOD_ROCKET", 0, false, 0)
+		m.addGame()
+		m.addKill("MOD_ROCKET")
+	})
+}
+
+func TestLineType(t *testing.T) {
+	tests := []struct {
+		line   string
+		want   string
+		wantOk bool
+	}{
+		{line: "  0:00 InitGame: \\sv_floodProtect\\1", want: "InitGame", wantOk: true},
+		{line: " 20:54 Kill: 2 3 6: Isgalamido killed Zeh by MOD_ROCKET", want: "Kill", wantOk: true},
+		{line: "981:21 say: Oootsimo: team red", want: "say", wantOk: true},
+		{line: " 10:12 red:8  blue:6", want: LineTypeTeamScore, wantOk: true},
+		{line: " 20:37 ------------------------------------------------------------", want: LineTypeSeparator, wantOk: true},
+		{line: " 20:37 something else", want: LineTypeOther, wantOk: true},
+		{line: "26  0:00 ------------------------------------------------------------", want: "", wantOk: false},
+		{line: "", want: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			lineType, ok := LineType(tt.line)
+			assert.Equal(t, tt.want, lineType)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// PlayerName is the name of a player as shown in game, with its color codes, and normalized.
+type PlayerName struct {
+	DisplayName string `json:"display_name"`
+	CleanName   string `json:"clean_name"`
+}
+
+// colorCode matches the Quake color codes, a caret followed by any character but another caret.
+var colorCode = regexp.MustCompile(`\^[^^]`)
+
+// StripColors removes the color codes of the name, e.g. ^1Red^7Name becomes RedName.
+func StripColors(name string) string {
+	return colorCode.ReplaceAllString(name, "")
+}
+
+// CleanName normalizes the name: no color codes, no control characters and single spaces only,
+// none leading or trailing.
+func CleanName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, StripColors(name))
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// playerName returns the name the player is known by in the games: its canonical player when
+// aliased, else its clean name, or the name as logged when the parser keeps the color codes or
+// nothing is left of it once cleaned.
+func (p *Parser) playerName(logged string) string {
+	if player, ok := p.Aliases.Player(logged); ok {
+		return player
+	}
+	if p.KeepColors {
+		return logged
+	}
+	if clean := CleanName(logged); clean != "" {
+		return clean
+	}
+	return logged
+}
+
+// addName records the names of the player in the game.
+func (p *Parser) addName(player, logged string) {
+	names := p.log[p.gameKey()].Names
+	if names == nil {
+		return
+	}
+	names[player] = PlayerName{DisplayName: logged, CleanName: CleanName(logged)}
+}
+
+// displayName returns the name of the player as logged, color codes included.
+func (p *Parser) displayName(player string) string {
+	if name, ok := p.log[p.gameKey()].Names[player]; ok {
+		return name.DisplayName
+	}
+	return player
+}
@@ -0,0 +1,76 @@
+//go:build unit
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCleanName(t *testing.T) {
+	tests := []struct {
+		name      string
+		logged    string
+		wantStrip string
+		wantClean string
+	}{
+		{name: "Plain", logged: "Isgalamido", wantStrip: "Isgalamido", wantClean: "Isgalamido"},
+		{name: "Colors", logged: "^1Red^7Name", wantStrip: "RedName", wantClean: "RedName"},
+		{name: "Double caret", logged: "a^^b", wantStrip: "a^", wantClean: "a^"},
+		{name: "Spaces", logged: " ^2Dono  da ^3bola ", wantStrip: " Dono  da bola ", wantClean: "Dono da bola"},
+		{name: "Control characters", logged: "Zeh\x07\x1b", wantStrip: "Zeh\x07\x1b", wantClean: "Zeh"},
+		{name: "Only colors", logged: "^1^2", wantStrip: "", wantClean: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantStrip, StripColors(tt.logged))
+			assert.Equal(t, tt.wantClean, CleanName(tt.logged))
+		})
+	}
+}
+
+func TestParser_playerName(t *testing.T) {
+	tests := []struct {
+		name       string
+		keepColors bool
+		logged     string
+		want       string
+	}{
+		{name: "Stripped", logged: "^1Red^7Name", want: "RedName"},
+		{name: "Kept", keepColors: true, logged: "^1Red^7Name", want: "^1Red^7Name"},
+		{name: "Nothing left", logged: "^1^2", want: "^1^2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Parser{KeepColors: tt.keepColors}
+			assert.Equal(t, tt.want, p.playerName(tt.logged))
+		})
+	}
+}
+
+func TestParser_ParseLine_names(t *testing.T) {
+	lines := []string{
+		`  0:00 InitGame: \sv_hostname\Code Miner Server\g_gametype\0\mapname\q3dm17`,
+		`  0:01 ClientUserinfoChanged: 2 n\^1Red^7Name\t\0\model\sarge`,
+		`  0:02 ClientUserinfoChanged: 3 n\Dono: da bola killed\t\0\model\sarge`,
+		`  0:03 Kill: 2 3 7: ^1Red^7Name killed Dono: da bola killed by MOD_ROCKET_SPLASH`,
+		`  0:04 say: Dono: da bola killed: gg`,
+	}
+
+	p := Parser{}
+	for _, line := range lines {
+		p.ParseLine(line)
+	}
+	p.End()
+
+	game := p.log["game_01"]
+	assert.Equal(t, []string{"RedName", "Dono: da bola killed"}, game.Players)
+	assert.Equal(t, map[string]int{"RedName": 1}, game.Kills)
+	assert.Equal(t, PlayerName{DisplayName: "^1Red^7Name", CleanName: "RedName"}, game.Names["RedName"])
+	assert.Empty(t, game.Inconsistencies)
+	if assert.Len(t, game.Chat, 1) {
+		assert.Equal(t, "Dono: da bola killed", game.Chat[0].Player)
+		assert.Equal(t, "gg", game.Chat[0].Message)
+	}
+}
@@ -4,57 +4,203 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type (
 	Parser struct {
+		// OnEvent, when set, is called with every game event as the lines are parsed.
+		OnEvent func(Event)
+		// Metrics, when set, counts the lines, games and kills parsed.
+		Metrics *Metrics
+		// MeansByFamily, when set, also counts the kills of every game by weapon family,
+		// so that e.g. MOD_ROCKET and MOD_ROCKET_SPLASH are counted together.
+		MeansByFamily bool
+		// KeepColors, when set, names the players as logged, with their color codes,
+		// rather than by their clean names.
+		KeepColors bool
+		// Aliases, when set, names the players by their canonical players.
+		Aliases Aliases
+		// Scoring, when set, replaces the default scoring rules of Kills.
+		Scoring *ScoringRules
+		// KeepAllPlayers, when set, lists every player of Players in Kills and in the per-player
+		// stats, with explicit zeros, even when they never scored.
+		KeepAllPlayers bool
+		// Dialect, when set, decodes every game in the dialect rather than in the one detected
+		// from the gamename and version of its InitGame line.
+		Dialect Dialect
+		// Aggregators, when set, compute custom stats of every game, added to the output of the
+		// game under their keys, which can't be the keys of the game fields. Games resumed from a
+		// checkpoint only have the events since.
+		Aggregators map[string]Aggregator
+
 		line        string
 		errorState  bool
 		gameCounter int
-		log         map[string]Game `json:"log"`
+		clients     map[string]string
+		sessions    map[string]int
+		lastTime    int
+		flags       map[string]flagState
+		ctfEvents   bool
+		gameOver    bool
+		awards      awardState
+		dialect     Dialect
+		log         map[string]Game
+
+		aggregators    []Aggregator
+		aggregatorKeys []string
+		aggregatorErr  error
+		kills          *killsAggregator
 	}
 
 	Game struct {
-		TotalKills   int            `json:"total_kills"`
-		Players      []string       `json:"players"`
-		Kills        map[string]int `json:"kills"`
-		KillsByMeans map[string]int `json:"kills_by_means"`
+		Map             string                    `json:"map"`
+		TotalKills      int                       `json:"total_kills"`
+		Players         []string                  `json:"players"`
+		Names           map[string]PlayerName     `json:"names"`
+		Kills           map[string]int            `json:"kills"`
+		KillsByMeans    map[string]int            `json:"kills_by_means"`
+		KillsByFamily   map[string]int            `json:"kills_by_family,omitempty"`
+		ItemsByCategory map[string]int            `json:"items_by_category"`
+		ItemsByPlayer   map[string]map[string]int `json:"items_by_player"`
+		Chat            []Message                 `json:"chat"`
+		Connections     map[string]*Connection    `json:"connections"`
+		KillMatrix      KillMatrix                `json:"kill_matrix"`
+		Awards          *Awards                   `json:"awards,omitempty"`
+		// Inconsistencies lists the Kill lines whose names disagree with their IDs.
+		Inconsistencies []Inconsistency `json:"inconsistencies,omitempty"`
+		Teams           *Teams          `json:"teams,omitempty"`
+		CTF             *CTF            `json:"ctf,omitempty"`
+		// Aggregates holds the results of the Parser.Aggregators, by key, once the game is over.
+		Aggregates map[string]any `json:"-"`
+	}
+
+	// Teams holds the team play state of a game, only present for team game types.
+	Teams struct {
+		Players   map[string]string `json:"players"`
+		Switches  map[string]int    `json:"switches"`
+		Kills     map[string]int    `json:"kills"`
+		TeamKills map[string]int    `json:"team_kills"`
+		Scores    map[string]int    `json:"scores"`
 	}
 )
 
+const (
+	TeamFree      = "free"
+	TeamRed       = "red"
+	TeamBlue      = "blue"
+	TeamSpectator = "spectator"
+
+	// GameTypeTeam is the first g_gametype value played in teams (team deathmatch, then CTF).
+	GameTypeTeam = 3
+	GameTypeCTF  = 4
+)
+
+var teamNames = map[string]string{
+	"0": TeamFree,
+	"1": TeamRed,
+	"2": TeamBlue,
+	"3": TeamSpectator,
+}
+
 func (p *Parser) Parse(filename string) (string, error) {
-	file, err := os.Open(filename)
+	games, err := p.ParseGames(filename)
 	if err != nil {
 		return "", err
 	}
+
+	out, _ := json.Marshal(games)
+	return string(out), nil
+}
+
+// ParseGames parses the log file into its games, keyed by game_XX.
+func (p *Parser) ParseGames(filename string) (map[string]Game, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
 	defer file.Close()
 
+	return p.ParseReader(file)
+}
+
+// ParseReader parses a log read from r into its games, keyed by game_XX.
+func (p *Parser) ParseReader(r io.Reader) (map[string]Game, error) {
 	p.log = make(map[string]Game)
+	p.gameCounter = 0
+	p.errorState = false
+	p.aggregators = nil
+	if err := p.CheckAggregators(); err != nil {
+		return nil, err
+	}
 
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
 		p.parseLine(scanner.Text())
 	}
 	if err := scanner.Err(); err != nil {
-		return "", err
+		return nil, err
 	}
+	p.End()
 
-	out, _ := json.Marshal(p.log)
-	return string(out), nil
+	return p.log, nil
+}
+
+// End ends the game in progress at the time of the last line, for callers feeding the parser
+// with ParseLine once the log is over.
+func (p *Parser) End() {
+	if timestamp, ok := parseTimestamp(p.line); ok {
+		p.lastTime = timestamp
+	}
+	p.endGame(p.lastTime)
+}
+
+// ParseLine parses a single line, for callers feeding the parser as the log grows.
+func (p *Parser) ParseLine(line string) {
+	if p.log == nil {
+		p.log = make(map[string]Game)
+	}
+	p.parseLine(line)
+}
+
+// Games returns the games parsed so far, keyed by game_XX.
+func (p *Parser) Games() map[string]Game {
+	return p.log
 }
 
 func (p *Parser) parseLine(line string) {
+	if p.Metrics != nil {
+		defer p.observeLine(line, time.Now(), p.errorState)
+	}
+
+	if timestamp, ok := parseTimestamp(p.line); ok {
+		p.lastTime = timestamp
+	}
 	p.line = line
 
 	p.checkErrorState()
-	if p.initGame() || p.addPlayer() || p.addKill() {
+	if p.addMessage() || p.initGame() {
+		return
+	}
+	// The other lines only count in a game, those before the first InitGame being skipped.
+	if _, ok := p.log[p.gameKey()]; !ok {
+		return
+	}
+	if p.addPlayer() || p.addKill() || p.addTeamScore() || p.addFlag() || p.addCTFEvent() || p.addItem() ||
+		p.connectClient() || p.beginClient() || p.disconnectClient() || p.shutdownGame() {
 		return
 	}
 }
 
+func (p *Parser) observeLine(line string, start time.Time, wasErrorState bool) {
+	p.Metrics.observeLine(line, time.Since(start), p.errorState && !wasErrorState, len(p.sessions))
+}
+
 func (p *Parser) gameKey() string {
 	return fmt.Sprintf("game_%02d", p.gameCounter)
 }
@@ -72,92 +218,292 @@ func (p *Parser) initGame() bool {
 		return false
 	}
 
+	p.endGame(p.lastTime)
 	p.errorState = false
 	p.gameCounter++
+	p.clients = make(map[string]string)
+	p.sessions = make(map[string]int)
+	p.flags = make(map[string]flagState)
+	p.ctfEvents = false
+	p.gameOver = false
+	p.awards = newAwardState()
+	info := p.serverInfo()
+	p.dialect = DetectDialect(info["gamename"], info["version"])
+	p.emit(Event{Type: EventGameStart})
+	p.Metrics.addGame()
 	if _, ok := p.log[p.gameKey()]; !ok {
-		p.log[p.gameKey()] = Game{
-			Players:      make([]string, 0),
-			Kills:        make(map[string]int),
-			KillsByMeans: make(map[string]int),
+		game := Game{
+			Map:             info["mapname"],
+			Players:         make([]string, 0),
+			Names:           make(map[string]PlayerName),
+			Kills:           make(map[string]int),
+			KillsByMeans:    make(map[string]int),
+			ItemsByCategory: make(map[string]int),
+			ItemsByPlayer:   make(map[string]map[string]int),
+			Chat:            make([]Message, 0),
+			Connections:     make(map[string]*Connection),
+			KillMatrix:      make(KillMatrix),
+			Awards:          newAwards(),
+		}
+		if p.MeansByFamily {
+			game.KillsByFamily = make(map[string]int)
+		}
+		teams, ctf := p.gameDialect().GameType(p.gameType())
+		if teams {
+			game.Teams = &Teams{
+				Players:   make(map[string]string),
+				Switches:  make(map[string]int),
+				Kills:     make(map[string]int),
+				TeamKills: make(map[string]int),
+				Scores:    make(map[string]int),
+			}
+		}
+		if ctf {
+			game.CTF = &CTF{
+				Players: make(map[string]*FlagStats),
+				Teams:   make(map[string]*FlagStats),
+			}
 		}
+		p.log[p.gameKey()] = game
 	}
 
 	return true
 }
 
+// gameDialect returns the dialect of the game in progress.
+func (p *Parser) gameDialect() Dialect {
+	if p.Dialect != nil {
+		return p.Dialect
+	}
+	if p.dialect != nil {
+		return p.dialect
+	}
+	return Baseq3{}
+}
+
+var gameTypeRegexp = regexp.MustCompile(`\\g_gametype\\[= ]*(\d+)`)
+
+func (p *Parser) gameType() int {
+	matches := gameTypeRegexp.FindStringSubmatch(p.line)
+	if len(matches) < 2 {
+		return 0
+	}
+
+	gameType, _ := strconv.Atoi(matches[1])
+	return gameType
+}
+
+var serverInfoPair = regexp.MustCompile(`\\([^\\]*)\\([^\\]*)`)
+
+// serverInfo returns the keys and values of the server info of the InitGame line, the first
+// value of a key repeated.
+func (p *Parser) serverInfo() map[string]string {
+	info := make(map[string]string)
+	for _, pair := range serverInfoPair.FindAllStringSubmatch(p.line, -1) {
+		if _, ok := info[pair[1]]; !ok {
+			info[pair[1]] = pair[2]
+		}
+	}
+	return info
+}
+
 func (p *Parser) addPlayer() bool {
-	if p.errorState || !strings.Contains(p.line, "ClientUserinfoChanged:") {
+	if p.errorState || p.gameDialect().Recognize(p.line) != LineUserinfo {
 		return false
 	}
 
-	matches := regexp.MustCompile(`ClientUserinfoChanged: \d+ n\\([^\\]+)\\`).FindStringSubmatch(p.line)
-	if len(matches) < 2 {
+	userinfo, ok := p.gameDialect().Decode(LineUserinfo, p.line)
+	if !ok {
 		p.errorState = true
 		return true
 	}
 
-	newPlayerName := matches[1]
+	newPlayerName := p.playerName(userinfo.Name)
+	p.addName(newPlayerName, userinfo.Name)
+	renamed := p.renameClient(userinfo.ClientID, newPlayerName)
+	p.setClient(userinfo.ClientID, newPlayerName)
+	p.joinClient(userinfo.ClientID, renamed)
+	p.setTeam(newPlayerName, userinfo.Team)
+	p.addGamePlayer(newPlayerName)
+	return true
+}
+
+// addGamePlayer adds the player to the players of the game, unless already there.
+func (p *Parser) addGamePlayer(player string) {
 	game := p.log[p.gameKey()]
 	for _, existingPlayer := range game.Players {
-		if existingPlayer == newPlayerName {
-			return true
+		if existingPlayer == player {
+			return
 		}
 	}
 
-	game.Players = append(game.Players, newPlayerName)
+	game.Players = append(game.Players, player)
 	p.log[p.gameKey()] = game
-	return true
+	p.addScoreboardPlayer(player)
 }
 
-func (p *Parser) addKill() bool {
-	if p.errorState || !strings.Contains(p.line, "Kill:") {
-		return false
+// addScoreboardPlayer lists the player with zeros in the per-player stats of the game, and the
+// teams with zeros in the per-team stats, when the parser keeps every player.
+func (p *Parser) addScoreboardPlayer(player string) {
+	if !p.KeepAllPlayers {
+		return
 	}
 
-	matches := regexp.MustCompile(`Kill: \d+ \d+ \d+: (.+?) killed (.+?) by ([^ ]+)`).FindStringSubmatch(p.line)
-	if len(matches) < 4 {
-		p.errorState = true
-		return true
-	}
+	p.gameKills(p.gameKey()).keep(player)
+	p.writeKills(p.gameKey())
 
 	game := p.log[p.gameKey()]
-	game.TotalKills++
-	p.log[p.gameKey()] = game
+	if _, ok := game.ItemsByPlayer[player]; !ok && game.ItemsByPlayer != nil {
+		game.ItemsByPlayer[player] = make(map[string]int)
+	}
+	if _, ok := game.KillMatrix[player]; !ok && game.KillMatrix != nil {
+		game.KillMatrix[player] = make(map[string]int)
+	}
+	if game.Awards != nil {
+		if _, ok := game.Awards.Streaks[player]; !ok {
+			game.Awards.Streaks[player] = 0
+		}
+		if _, ok := game.Awards.MultiKills[player]; !ok {
+			game.Awards.MultiKills[player] = &MultiKills{}
+		}
+		if _, ok := game.Awards.Dominations[player]; !ok {
+			game.Awards.Dominations[player] = make(map[string]int)
+		}
+	}
+	if game.Teams != nil {
+		if _, ok := game.Teams.Switches[player]; !ok {
+			game.Teams.Switches[player] = 0
+		}
+		for _, team := range []string{TeamRed, TeamBlue} {
+			if _, ok := game.Teams.Kills[team]; !ok {
+				game.Teams.Kills[team] = 0
+			}
+			if _, ok := game.Teams.TeamKills[team]; !ok {
+				game.Teams.TeamKills[team] = 0
+			}
+		}
+	}
+	if game.CTF != nil {
+		if _, ok := game.CTF.Players[player]; !ok {
+			game.CTF.Players[player] = &FlagStats{}
+		}
+		for _, team := range []string{TeamRed, TeamBlue} {
+			if _, ok := game.CTF.Teams[team]; !ok {
+				game.CTF.Teams[team] = &FlagStats{}
+			}
+		}
+	}
+}
 
-	killer, victim, weapon := matches[1], matches[2], matches[3]
-	p.addWeaponKill(weapon)
+func (p *Parser) setClient(id, player string) {
+	if p.clients == nil {
+		p.clients = make(map[string]string)
+	}
+	p.clients[id] = player
+}
 
-	if killer == victim {
-		return true
+// timestamp returns the game time of the current line in seconds.
+func (p *Parser) timestamp() int {
+	timestamp, _ := parseTimestamp(p.line)
+	return timestamp
+}
+
+var timestampRegexp = regexp.MustCompile(`^\s*(\d+):(\d{2}) `)
+
+func parseTimestamp(line string) (int, bool) {
+	matches := timestampRegexp.FindStringSubmatch(line)
+	if len(matches) < 3 {
+		return 0, false
+	}
+
+	minutes, _ := strconv.Atoi(matches[1])
+	seconds, _ := strconv.Atoi(matches[2])
+	return minutes*60 + seconds, true
+}
+
+func (p *Parser) addKill() bool {
+	if p.errorState || p.gameDialect().Recognize(p.line) != LineKill {
+		return false
 	}
 
-	if killer != "<world>" {
-		p.addPlayerKill(killer)
+	kill, ok := p.gameDialect().Decode(LineKill, p.line)
+	if !ok {
+		p.errorState = true
+		return true
+	}
+	killer, victim, weapon, ok := p.resolveKill(kill)
+	if !ok {
+		p.errorState = true
 		return true
 	}
 
-	p.addWorldKill(victim)
+	p.Metrics.addKill(weapon)
+	p.emit(Event{Type: EventKill, Killer: killer, Victim: victim, Means: weapon})
+	p.addTeamKill(killer, victim)
+	p.dropFlag(killer, victim, weapon)
+	p.addMatrixKill(killer, victim)
+	p.addAwards(killer, victim)
 	return true
 }
 
-func (p *Parser) addWeaponKill(weapon string) {
-	p.log[p.gameKey()].KillsByMeans[weapon]++
-}
+func (p *Parser) setTeam(player, teamID string) {
+	teams := p.log[p.gameKey()].Teams
+	if teams == nil {
+		return
+	}
+
+	team, ok := teamNames[teamID]
+	if !ok {
+		return
+	}
 
-func (p *Parser) addPlayerKill(killer string) {
-	p.log[p.gameKey()].Kills[killer]++
-	p.handleZeroKills(killer)
+	if current, ok := teams.Players[player]; ok && current != team {
+		teams.Switches[player]++
+	}
+	teams.Players[player] = team
 }
 
-func (p *Parser) addWorldKill(victim string) {
-	p.log[p.gameKey()].Kills[victim]--
-	p.handleZeroKills(victim)
+func (p *Parser) addTeamKill(killer, victim string) {
+	teams := p.log[p.gameKey()].Teams
+	if teams == nil || killer == victim {
+		return
+	}
+
+	team := teams.Players[killer]
+	if team != TeamRed && team != TeamBlue {
+		return
+	}
+
+	if p.teamKill(killer, victim) {
+		teams.TeamKills[team]++
+		return
+	}
+	teams.Kills[team]++
 }
 
-func (p *Parser) handleZeroKills(player string) {
-	if _, ok := p.log[p.gameKey()].Kills[player]; ok {
-		if p.log[p.gameKey()].Kills[player] == 0 {
-			delete(p.log[p.gameKey()].Kills, player)
-		}
+var (
+	teamScoreLine = regexp.MustCompile(`^\s*\d+:\d{2} red:`)
+	teamScoreText = regexp.MustCompile(`red:(-?\d+)\s+blue:(-?\d+)`)
+)
+
+// addTeamScore sets the team scores of a red:N blue:N line, ignoring the line when it has no
+// scores to read rather than dropping the game.
+func (p *Parser) addTeamScore() bool {
+	if p.errorState || !teamScoreLine.MatchString(p.line) {
+		return false
 	}
+
+	matches := teamScoreText.FindStringSubmatch(p.line)
+	if len(matches) < 3 {
+		return true
+	}
+
+	teams := p.log[p.gameKey()].Teams
+	if teams == nil {
+		return true
+	}
+
+	teams.Scores[TeamRed], _ = strconv.Atoi(matches[1])
+	teams.Scores[TeamBlue], _ = strconv.Atoi(matches[2])
+	return true
 }
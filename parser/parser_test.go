@@ -22,7 +22,7 @@ func TestParser_Parse(t *testing.T) {
 			name:       "Success",
 			filename:   "./test/Parse_1.log",
 			fields:     Parser{},
-			wantParsed: "{\"game_01\":{\"total_kills\":0,\"players\":[\"Isgalamido\"],\"kills\":{},\"kills_by_means\":{}},\"game_02\":{\"total_kills\":11,\"players\":[\"Isgalamido\",\"Dono da Bola\",\"Mocinha\"],\"kills\":{\"Isgalamido\":-7},\"kills_by_means\":{\"MOD_FALLING\":1,\"MOD_ROCKET_SPLASH\":3,\"MOD_TRIGGER_HURT\":7}},\"game_03\":{\"total_kills\":4,\"players\":[\"Dono da Bola\",\"Mocinha\",\"Isgalamido\",\"Zeh\"],\"kills\":{\"Dono da Bola\":-1,\"Isgalamido\":1,\"Zeh\":-2},\"kills_by_means\":{\"MOD_FALLING\":1,\"MOD_ROCKET\":1,\"MOD_TRIGGER_HURT\":2}},\"game_04\":{\"total_kills\":105,\"players\":[\"Dono da Bola\",\"Isgalamido\",\"Zeh\",\"Assasinu Credi\"],\"kills\":{\"Assasinu Credi\":12,\"Dono da Bola\":9,\"Isgalamido\":19,\"Zeh\":20},\"kills_by_means\":{\"MOD_FALLING\":11,\"MOD_MACHINEGUN\":4,\"MOD_RAILGUN\":8,\"MOD_ROCKET\":20,\"MOD_ROCKET_SPLASH\":51,\"MOD_SHOTGUN\":2,\"MOD_TRIGGER_HURT\":9}},\"game_05\":{\"total_kills\":14,\"players\":[\"Dono da Bola\",\"Isgalamido\",\"Zeh\",\"Assasinu Credi\"],\"kills\":{\"Assasinu Credi\":-1,\"Isgalamido\":2,\"Zeh\":1},\"kills_by_means\":{\"MOD_RAILGUN\":1,\"MOD_ROCKET\":4,\"MOD_ROCKET_SPLASH\":4,\"MOD_TRIGGER_HURT\":5}},\"game_06\":{\"total_kills\":29,\"players\":[\"Fasano Again\",\"Oootsimo\",\"Isgalamido\",\"Zeh\",\"Dono da Bola\",\"UnnamedPlayer\",\"Maluquinho\",\"Assasinu Credi\",\"Mal\"],\"kills\":{\"Assasinu Credi\":1,\"Dono da Bola\":2,\"Isgalamido\":3,\"Oootsimo\":8,\"Zeh\":7},\"kills_by_means\":{\"MOD_FALLING\":1,\"MOD_MACHINEGUN\":1,\"MOD_RAILGUN\":2,\"MOD_ROCKET\":5,\"MOD_ROCKET_SPLASH\":13,\"MOD_SHOTGUN\":4,\"MOD_TRIGGER_HURT\":3}},\"game_07\":{\"total_kills\":130,\"players\":[\"Oootsimo\",\"Isgalamido\",\"Zeh\",\"Dono da Bola\",\"Mal\",\"Assasinu Credi\",\"Chessus!\",\"Chessus\"],\"kills\":{\"Assasinu Credi\":19,\"Dono da Bola\":10,\"Isgalamido\":14,\"Mal\":-3,\"Oootsimo\":20,\"Zeh\":8},\"kills_by_means\":{\"MOD_FALLING\":7,\"MOD_MACHINEGUN\":9,\"MOD_RAILGUN\":9,\"MOD_ROCKET\":29,\"MOD_ROCKET_SPLASH\":49,\"MOD_SHOTGUN\":7,\"MOD_TRIGGER_HURT\":20}},\"game_08\":{\"total_kills\":89,\"players\":[\"Oootsimo\",\"Isgalamido\",\"Zeh\",\"Dono da Bola\",\"Mal\",\"Assasinu Credi\"],\"kills\":{\"Assasinu Credi\":9,\"Dono da Bola\":1,\"Isgalamido\":20,\"Mal\":-3,\"Oootsimo\":15,\"Zeh\":12},\"kills_by_means\":{\"MOD_FALLING\":6,\"MOD_MACHINEGUN\":4,\"MOD_RAILGUN\":12,\"MOD_ROCKET\":18,\"MOD_ROCKET_SPLASH\":39,\"MOD_SHOTGUN\":1,\"MOD_TRIGGER_HURT\":9}},\"game_09\":{\"total_kills\":67,\"players\":[\"Oootsimo\",\"Isgalamido\",\"Zeh\",\"Dono da Bola\",\"Mal\",\"Assasinu Credi\",\"Chessus!\",\"Chessus\"],\"kills\":{\"Assasinu Credi\":7,\"Chessus\":8,\"Dono da Bola\":1,\"Isgalamido\":1,\"Mal\":2,\"Oootsimo\":8,\"Zeh\":12},\"kills_by_means\":{\"MOD_FALLING\":3,\"MOD_MACHINEGUN\":3,\"MOD_RAILGUN\":10,\"MOD_ROCKET\":17,\"MOD_ROCKET_SPLASH\":25,\"MOD_SHOTGUN\":1,\"MOD_TRIGGER_HURT\":8}},\"game_10\":{\"total_kills\":60,\"players\":[\"Oootsimo\",\"Dono da Bola\",\"Zeh\",\"Chessus\",\"Mal\",\"Assasinu Credi\",\"Isgalamido\"],\"kills\":{\"Assasinu Credi\":3,\"Chessus\":5,\"Dono da Bola\":3,\"Isgalamido\":5,\"Mal\":1,\"Oootsimo\":-1,\"Zeh\":7},\"kills_by_means\":{\"MOD_BFG\":2,\"MOD_BFG_SPLASH\":2,\"MOD_CRUSH\":1,\"MOD_MACHINEGUN\":1,\"MOD_RAILGUN\":7,\"MOD_ROCKET\":4,\"MOD_ROCKET_SPLASH\":1,\"MOD_TELEFRAG\":25,\"MOD_TRIGGER_HURT\":17}},\"game_11\":{\"total_kills\":20,\"players\":[\"Dono da Bola\",\"Isgalamido\",\"Zeh\",\"Oootsimo\",\"Chessus\",\"Assasinu Credi\",\"UnnamedPlayer\",\"Mal\"],\"kills\":{\"Assasinu Credi\":-3,\"Dono da Bola\":-2,\"Isgalamido\":4,\"Oootsimo\":4},\"kills_by_means\":{\"MOD_BFG_SPLASH\":3,\"MOD_CRUSH\":1,\"MOD_MACHINEGUN\":1,\"MOD_RAILGUN\":4,\"MOD_ROCKET_SPLASH\":4,\"MOD_TRIGGER_HURT\":7}},\"game_12\":{\"total_kills\":160,\"players\":[\"Isgalamido\",\"Dono da Bola\",\"Zeh\",\"Oootsimo\",\"Chessus\",\"Assasinu Credi\",\"Mal\"],\"kills\":{\"Assasinu Credi\":18,\"Chessus\":12,\"Dono da Bola\":3,\"Isgalamido\":24,\"Mal\":-7,\"Oootsimo\":12,\"Zeh\":11},\"kills_by_means\":{\"MOD_BFG\":8,\"MOD_BFG_SPLASH\":8,\"MOD_FALLING\":2,\"MOD_MACHINEGUN\":7,\"MOD_RAILGUN\":38,\"MOD_ROCKET\":25,\"MOD_ROCKET_SPLASH\":35,\"MOD_TRIGGER_HURT\":37}},\"game_13\":{\"total_kills\":6,\"players\":[\"Isgalamido\",\"Dono da Bola\",\"Zeh\",\"Oootsimo\",\"Chessus\",\"Assasinu Credi\",\"Mal\"],\"kills\":{\"Dono da Bola\":-1,\"Isgalamido\":-1,\"Oootsimo\":1,\"Zeh\":2},\"kills_by_means\":{\"MOD_BFG\":1,\"MOD_BFG_SPLASH\":1,\"MOD_ROCKET\":1,\"MOD_ROCKET_SPLASH\":1,\"MOD_TRIGGER_HURT\":2}},\"game_14\":{\"total_kills\":122,\"players\":[\"Isgalamido\",\"Dono da Bola\",\"Zeh\",\"Oootsimo\",\"Chessus\",\"Assasinu Credi\",\"Mal\"],\"kills\":{\"Assasinu Credi\":3,\"Chessus\":7,\"Dono da Bola\":1,\"Isgalamido\":22,\"Mal\":-5,\"Oootsimo\":9,\"Zeh\":4},\"kills_by_means\":{\"MOD_BFG\":5,\"MOD_BFG_SPLASH\":10,\"MOD_FALLING\":5,\"MOD_MACHINEGUN\":4,\"MOD_RAILGUN\":20,\"MOD_ROCKET\":23,\"MOD_ROCKET_SPLASH\":24,\"MOD_TRIGGER_HURT\":31}},\"game_15\":{\"total_kills\":3,\"players\":[\"Zeh\",\"Assasinu Credi\",\"Dono da Bola\",\"Fasano Again\",\"Isgalamido\",\"Oootsimo\"],\"kills\":{\"Zeh\":-3},\"kills_by_means\":{\"MOD_TRIGGER_HURT\":3}},\"game_16\":{\"total_kills\":0,\"players\":[\"Dono da Bola\",\"Oootsimo\",\"Isgalamido\",\"Assasinu Credi\",\"Zeh\"],\"kills\":{},\"kills_by_means\":{}},\"game_17\":{\"total_kills\":13,\"players\":[\"Dono da Bola\",\"Oootsimo\",\"Isgalamido\",\"Assasinu Credi\",\"Zeh\",\"UnnamedPlayer\",\"Mal\"],\"kills\":{\"Assasinu Credi\":-3,\"Dono da Bola\":-2,\"Mal\":-1},\"kills_by_means\":{\"MOD_FALLING\":3,\"MOD_RAILGUN\":2,\"MOD_ROCKET_SPLASH\":2,\"MOD_TRIGGER_HURT\":6}},\"game_18\":{\"total_kills\":7,\"players\":[\"Dono da Bola\",\"Oootsimo\",\"Isgalamido\",\"Assasinu Credi\",\"Zeh\",\"Mal\"],\"kills\":{\"Assasinu Credi\":2,\"Dono da Bola\":-1,\"Isgalamido\":1,\"Mal\":-1,\"Zeh\":2},\"kills_by_means\":{\"MOD_FALLING\":1,\"MOD_ROCKET\":1,\"MOD_ROCKET_SPLASH\":4,\"MOD_TRIGGER_HURT\":1}},\"game_19\":{\"total_kills\":95,\"players\":[\"Isgalamido\",\"Oootsimo\",\"Dono da Bola\",\"Assasinu Credi\",\"Zeh\",\"Mal\"],\"kills\":{\"Assasinu Credi\":8,\"Dono da Bola\":12,\"Isgalamido\":13,\"Mal\":2,\"Oootsimo\":10,\"Zeh\":20},\"kills_by_means\":{\"MOD_FALLING\":1,\"MOD_MACHINEGUN\":7,\"MOD_RAILGUN\":10,\"MOD_ROCKET\":27,\"MOD_ROCKET_SPLASH\":32,\"MOD_SHOTGUN\":6,\"MOD_TRIGGER_HURT\":12}},\"game_20\":{\"total_kills\":3,\"players\":[\"Isgalamido\",\"Oootsimo\",\"Dono da Bola\",\"Assasinu Credi\",\"Zeh\",\"Mal\"],\"kills\":{\"Dono da Bola\":1,\"Oootsimo\":1},\"kills_by_means\":{\"MOD_ROCKET\":1,\"MOD_ROCKET_SPLASH\":2}},\"game_21\":{\"total_kills\":131,\"players\":[\"Isgalamido\",\"Oootsimo\",\"Dono da Bola\",\"Assasinu Credi\",\"Zeh\",\"Mal\"],\"kills\":{\"Assasinu Credi\":16,\"Dono da Bola\":12,\"Isgalamido\":17,\"Mal\":6,\"Oootsimo\":21,\"Zeh\":19},\"kills_by_means\":{\"MOD_FALLING\":3,\"MOD_MACHINEGUN\":4,\"MOD_RAILGUN\":9,\"MOD_ROCKET\":37,\"MOD_ROCKET_SPLASH\":60,\"MOD_SHOTGUN\":4,\"MOD_TRIGGER_HURT\":14}}}",
+			wantParsed: "{\"game_01\":{\"map\":\"q3dm17\",\"total_kills\":0,\"players\":[\"Isgalamido\"],\"names\":{\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"}},\"kills\":{},\"kills_by_means\":{},\"items_by_category\":{},\"items_by_player\":{},\"chat\":[],\"connections\":{\"Isgalamido\":{\"joined_at\":1234,\"left_at\":1237,\"time_on_server\":3,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{},\"awards\":{\"first_blood\":\"\",\"streaks\":{},\"multi_kills\":{},\"dominations\":{}}},\"game_02\":{\"map\":\"q3dm17\",\"total_kills\":11,\"players\":[\"Isgalamido\",\"Dono da Bola\",\"Mocinha\"],\"names\":{\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mocinha\":{\"display_name\":\"Mocinha\",\"clean_name\":\"Mocinha\"}},\"kills\":{\"Isgalamido\":-7},\"kills_by_means\":{\"MOD_FALLING\":1,\"MOD_ROCKET_SPLASH\":3,\"MOD_TRIGGER_HURT\":7},\"items_by_category\":{\"ammo\":13,\"armor\":20,\"health\":6,\"powerup\":1,\"weapon\":21},\"items_by_player\":{\"Isgalamido\":{\"ammo\":13,\"armor\":20,\"health\":6,\"powerup\":1,\"weapon\":21}},\"chat\":[],\"connections\":{\"Dono da Bola\":{\"joined_at\":1311,\"left_at\":1313,\"time_on_server\":2,\"reconnects\":0,\"present_at_end\":false},\"Isgalamido\":{\"joined_at\":1238,\"left_at\":1569,\"time_on_server\":326,\"reconnects\":1,\"present_at_end\":true},\"Mocinha\":{\"joined_at\":1313,\"left_at\":1331,\"time_on_server\":18,\"reconnects\":0,\"present_at_end\":false}},\"kill_matrix\":{\"Isgalamido\":{\"Isgalamido\":2,\"Mocinha\":1}},\"awards\":{\"first_blood\":\"Isgalamido\",\"streaks\":{\"Isgalamido\":1},\"multi_kills\":{},\"dominations\":{}}},\"game_03\":{\"map\":\"q3dm17\",\"total_kills\":4,\"players\":[\"Dono da Bola\",\"Mocinha\",\"Isgalamido\",\"Zeh\"],\"names\":{\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mocinha\":{\"display_name\":\"Mocinha\",\"clean_name\":\"Mocinha\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Dono da Bola\":-1,\"Isgalamido\":1,\"Zeh\":-2},\"kills_by_means\":{\"MOD_FALLING\":1,\"MOD_ROCKET\":1,\"MOD_TRIGGER_HURT\":2},\"items_by_category\":{\"ammo\":4,\"armor\":20,\"health\":3,\"weapon\":13},\"items_by_player\":{\"Dono da Bola\":{\"ammo\":1,\"armor\":6,\"health\":1,\"weapon\":2},\"Isgalamido\":{\"armor\":4,\"weapon\":7},\"Mocinha\":{\"ammo\":1,\"armor\":10,\"health\":1,\"weapon\":2},\"Zeh\":{\"ammo\":2,\"health\":1,\"weapon\":2}},\"chat\":[],\"connections\":{\"Dono da Bola\":{\"joined_at\":25,\"left_at\":107,\"time_on_server\":23,\"reconnects\":0,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":59,\"left_at\":107,\"time_on_server\":48,\"reconnects\":0,\"present_at_end\":true},\"Mocinha\":{\"joined_at\":27,\"left_at\":86,\"time_on_server\":59,\"reconnects\":0,\"present_at_end\":false},\"Zeh\":{\"joined_at\":66,\"left_at\":107,\"time_on_server\":41,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Isgalamido\":{\"Mocinha\":1}},\"awards\":{\"first_blood\":\"Isgalamido\",\"streaks\":{\"Isgalamido\":1},\"multi_kills\":{},\"dominations\":{}}},\"game_04\":{\"map\":\"q3dm17\",\"total_kills\":105,\"players\":[\"Dono da Bola\",\"Isgalamido\",\"Zeh\",\"Assasinu Credi\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":12,\"Dono da Bola\":9,\"Isgalamido\":19,\"Zeh\":20},\"kills_by_means\":{\"MOD_FALLING\":11,\"MOD_MACHINEGUN\":4,\"MOD_RAILGUN\":8,\"MOD_ROCKET\":20,\"MOD_ROCKET_SPLASH\":51,\"MOD_SHOTGUN\":2,\"MOD_TRIGGER_HURT\":9},\"items_by_category\":{\"ammo\":36,\"armor\":126,\"health\":16,\"powerup\":17,\"weapon\":194},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":8,\"armor\":26,\"health\":2,\"powerup\":1,\"weapon\":41},\"Dono da Bola\":{\"ammo\":6,\"armor\":27,\"health\":4,\"powerup\":3,\"weapon\":49},\"Isgalamido\":{\"ammo\":10,\"armor\":28,\"health\":4,\"powerup\":6,\"weapon\":52},\"Zeh\":{\"ammo\":12,\"armor\":45,\"health\":6,\"powerup\":7,\"weapon\":52}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":227,\"left_at\":733,\"time_on_server\":506,\"reconnects\":0,\"present_at_end\":true},\"Dono da Bola\":{\"joined_at\":107,\"left_at\":733,\"time_on_server\":626,\"reconnects\":0,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":107,\"left_at\":733,\"time_on_server\":626,\"reconnects\":0,\"present_at_end\":true},\"Zeh\":{\"joined_at\":107,\"left_at\":733,\"time_on_server\":626,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Assasinu Credi\":{\"Assasinu Credi\":1,\"Dono da Bola\":4,\"Isgalamido\":4,\"Zeh\":7},\"Dono da Bola\":{\"Assasinu Credi\":6,\"Dono da Bola\":4,\"Isgalamido\":4,\"Zeh\":6},\"Isgalamido\":{\"Assasinu Credi\":6,\"Dono da Bola\":9,\"Zeh\":12},\"Zeh\":{\"Assasinu Credi\":8,\"Dono da Bola\":7,\"Isgalamido\":7}},\"awards\":{\"first_blood\":\"Dono da Bola\",\"streaks\":{\"Assasinu Credi\":3,\"Dono da Bola\":2,\"Isgalamido\":6,\"Zeh\":4},\"multi_kills\":{\"Dono da Bola\":{\"double\":1,\"triple\":0,\"quad\":0},\"Zeh\":{\"double\":2,\"triple\":0,\"quad\":0}},\"dominations\":{\"Assasinu Credi\":{\"Zeh\":3},\"Dono da Bola\":{\"Assasinu Credi\":3,\"Zeh\":3},\"Isgalamido\":{\"Dono da Bola\":5,\"Zeh\":5},\"Zeh\":{\"Assasinu Credi\":3,\"Dono da Bola\":4,\"Isgalamido\":4}}}},\"game_05\":{\"map\":\"q3dm17\",\"total_kills\":14,\"players\":[\"Dono da Bola\",\"Isgalamido\",\"Zeh\",\"Assasinu Credi\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":-1,\"Isgalamido\":2,\"Zeh\":1},\"kills_by_means\":{\"MOD_RAILGUN\":1,\"MOD_ROCKET\":4,\"MOD_ROCKET_SPLASH\":4,\"MOD_TRIGGER_HURT\":5},\"items_by_category\":{\"ammo\":10,\"armor\":44,\"health\":6,\"weapon\":42},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":2,\"armor\":20,\"health\":3,\"weapon\":26},\"Dono da Bola\":{\"armor\":7,\"weapon\":1},\"Isgalamido\":{\"ammo\":1,\"armor\":1,\"health\":1,\"weapon\":5},\"Zeh\":{\"ammo\":7,\"armor\":16,\"health\":2,\"weapon\":10}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":734,\"left_at\":1007,\"time_on_server\":273,\"reconnects\":0,\"present_at_end\":false},\"Dono da Bola\":{\"joined_at\":734,\"left_at\":806,\"time_on_server\":72,\"reconnects\":0,\"present_at_end\":false},\"Isgalamido\":{\"joined_at\":734,\"left_at\":785,\"time_on_server\":51,\"reconnects\":0,\"present_at_end\":false},\"Zeh\":{\"joined_at\":734,\"left_at\":956,\"time_on_server\":213,\"reconnects\":1,\"present_at_end\":false}},\"kill_matrix\":{\"Assasinu Credi\":{\"Assasinu Credi\":2,\"Zeh\":3},\"Isgalamido\":{\"Dono da Bola\":1,\"Zeh\":1},\"Zeh\":{\"Assasinu Credi\":2}},\"awards\":{\"first_blood\":\"Isgalamido\",\"streaks\":{\"Assasinu Credi\":2,\"Isgalamido\":2,\"Zeh\":1},\"multi_kills\":{\"Isgalamido\":{\"double\":1,\"triple\":0,\"quad\":0}},\"dominations\":{}}},\"game_06\":{\"map\":\"q3dm17\",\"total_kills\":29,\"players\":[\"Fasano Again\",\"Oootsimo\",\"Isgalamido\",\"Zeh\",\"Dono da Bola\",\"UnnamedPlayer\",\"Maluquinho\",\"Assasinu Credi\",\"Mal\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Fasano Again\":{\"display_name\":\"Fasano Again\",\"clean_name\":\"Fasano Again\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Maluquinho\":{\"display_name\":\"Maluquinho\",\"clean_name\":\"Maluquinho\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"UnnamedPlayer\":{\"display_name\":\"UnnamedPlayer\",\"clean_name\":\"UnnamedPlayer\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":1,\"Dono da Bola\":2,\"Isgalamido\":3,\"Oootsimo\":8,\"Zeh\":7},\"kills_by_means\":{\"MOD_FALLING\":1,\"MOD_MACHINEGUN\":1,\"MOD_RAILGUN\":2,\"MOD_ROCKET\":5,\"MOD_ROCKET_SPLASH\":13,\"MOD_SHOTGUN\":4,\"MOD_TRIGGER_HURT\":3},\"items_by_category\":{\"ammo\":17,\"armor\":47,\"health\":6,\"powerup\":6,\"weapon\":61},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":2,\"armor\":1,\"health\":1,\"weapon\":6},\"Dono da Bola\":{\"armor\":1,\"weapon\":9},\"Isgalamido\":{\"ammo\":3,\"armor\":7,\"health\":1,\"powerup\":3,\"weapon\":12},\"Mal\":{\"ammo\":1,\"health\":1,\"weapon\":3},\"Maluquinho\":{\"ammo\":1,\"armor\":1,\"weapon\":1},\"Oootsimo\":{\"ammo\":5,\"armor\":32,\"health\":2,\"weapon\":14},\"Zeh\":{\"ammo\":5,\"armor\":5,\"health\":1,\"powerup\":3,\"weapon\":16}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":125,\"left_at\":212,\"time_on_server\":87,\"reconnects\":0,\"present_at_end\":true},\"Dono da Bola\":{\"joined_at\":79,\"left_at\":212,\"time_on_server\":133,\"reconnects\":0,\"present_at_end\":true},\"Fasano Again\":{\"joined_at\":7,\"left_at\":10,\"time_on_server\":3,\"reconnects\":0,\"present_at_end\":false},\"Isgalamido\":{\"joined_at\":15,\"left_at\":212,\"time_on_server\":197,\"reconnects\":0,\"present_at_end\":true},\"Mal\":{\"joined_at\":160,\"left_at\":212,\"time_on_server\":52,\"reconnects\":0,\"present_at_end\":true},\"Maluquinho\":{\"joined_at\":105,\"left_at\":160,\"time_on_server\":55,\"reconnects\":0,\"present_at_end\":false},\"Oootsimo\":{\"joined_at\":10,\"left_at\":212,\"time_on_server\":202,\"reconnects\":0,\"present_at_end\":true},\"UnnamedPlayer\":{\"joined_at\":81,\"left_at\":105,\"time_on_server\":24,\"reconnects\":0,\"present_at_end\":false},\"Zeh\":{\"joined_at\":17,\"left_at\":212,\"time_on_server\":195,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Assasinu Credi\":{\"Dono da Bola\":1},\"Dono da Bola\":{\"Isgalamido\":1,\"Zeh\":1},\"Isgalamido\":{\"Dono da Bola\":1,\"Oootsimo\":1,\"UnnamedPlayer\":1,\"Zeh\":1},\"Maluquinho\":{\"Zeh\":1},\"Oootsimo\":{\"Assasinu Credi\":2,\"Dono da Bola\":2,\"Isgalamido\":1,\"Zeh\":4},\"Zeh\":{\"Assasinu Credi\":1,\"Dono da Bola\":1,\"Isgalamido\":4,\"Mal\":2}},\"awards\":{\"first_blood\":\"Oootsimo\",\"streaks\":{\"Assasinu Credi\":1,\"Dono da Bola\":1,\"Isgalamido\":2,\"Maluquinho\":1,\"Oootsimo\":6,\"Zeh\":2},\"multi_kills\":{},\"dominations\":{\"Oootsimo\":{\"Zeh\":4},\"Zeh\":{\"Isgalamido\":4}}}},\"game_07\":{\"map\":\"q3dm17\",\"total_kills\":130,\"players\":[\"Oootsimo\",\"Isgalamido\",\"Zeh\",\"Dono da Bola\",\"Mal\",\"Assasinu Credi\",\"Chessus!\",\"Chessus\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Chessus\":{\"display_name\":\"Chessus\",\"clean_name\":\"Chessus\"},\"Chessus!\":{\"display_name\":\"Chessus!\",\"clean_name\":\"Chessus!\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":19,\"Dono da Bola\":10,\"Isgalamido\":14,\"Mal\":-3,\"Oootsimo\":20,\"Zeh\":8},\"kills_by_means\":{\"MOD_FALLING\":7,\"MOD_MACHINEGUN\":9,\"MOD_RAILGUN\":9,\"MOD_ROCKET\":29,\"MOD_ROCKET_SPLASH\":49,\"MOD_SHOTGUN\":7,\"MOD_TRIGGER_HURT\":20},\"items_by_category\":{\"ammo\":34,\"armor\":119,\"health\":21,\"powerup\":22,\"weapon\":240},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":3,\"armor\":11,\"health\":4,\"powerup\":4,\"weapon\":37},\"Chessus\":{\"weapon\":13},\"Dono da Bola\":{\"ammo\":4,\"armor\":23,\"health\":4,\"powerup\":5,\"weapon\":44},\"Isgalamido\":{\"ammo\":5,\"armor\":10,\"health\":1,\"powerup\":5,\"weapon\":35},\"Mal\":{\"ammo\":9,\"armor\":6,\"powerup\":2,\"weapon\":33},\"Oootsimo\":{\"ammo\":4,\"armor\":59,\"health\":7,\"powerup\":2,\"weapon\":43},\"Zeh\":{\"ammo\":9,\"armor\":10,\"health\":5,\"powerup\":4,\"weapon\":35}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":212,\"left_at\":682,\"time_on_server\":470,\"reconnects\":0,\"present_at_end\":true},\"Chessus\":{\"joined_at\":355,\"left_at\":478,\"time_on_server\":123,\"reconnects\":0,\"present_at_end\":false},\"Chessus!\":{\"joined_at\":353,\"left_at\":355,\"time_on_server\":2,\"reconnects\":0,\"present_at_end\":false},\"Dono da Bola\":{\"joined_at\":212,\"left_at\":682,\"time_on_server\":470,\"reconnects\":0,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":212,\"left_at\":682,\"time_on_server\":470,\"reconnects\":0,\"present_at_end\":true},\"Mal\":{\"joined_at\":212,\"left_at\":682,\"time_on_server\":470,\"reconnects\":0,\"present_at_end\":true},\"Oootsimo\":{\"joined_at\":212,\"left_at\":682,\"time_on_server\":470,\"reconnects\":0,\"present_at_end\":true},\"Zeh\":{\"joined_at\":212,\"left_at\":682,\"time_on_server\":470,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Assasinu Credi\":{\"Assasinu Credi\":3,\"Dono da Bola\":4,\"Isgalamido\":5,\"Mal\":5,\"Oootsimo\":3,\"Zeh\":2},\"Dono da Bola\":{\"Assasinu Credi\":2,\"Dono da Bola\":2,\"Isgalamido\":2,\"Mal\":2,\"Oootsimo\":4,\"Zeh\":2},\"Isgalamido\":{\"Assasinu Credi\":3,\"Chessus\":2,\"Dono da Bola\":5,\"Isgalamido\":2,\"Mal\":3,\"Oootsimo\":3,\"Zeh\":2},\"Mal\":{\"Assasinu Credi\":3,\"Dono da Bola\":2,\"Oootsimo\":2,\"Zeh\":2},\"Oootsimo\":{\"Assasinu Credi\":5,\"Dono da Bola\":5,\"Isgalamido\":2,\"Mal\":5,\"Zeh\":7},\"Zeh\":{\"Assasinu Credi\":3,\"Dono da Bola\":6,\"Mal\":1,\"Oootsimo\":3,\"Zeh\":1}},\"awards\":{\"first_blood\":\"Assasinu Credi\",\"streaks\":{\"Assasinu Credi\":5,\"Dono da Bola\":2,\"Isgalamido\":4,\"Mal\":2,\"Oootsimo\":4,\"Zeh\":4},\"multi_kills\":{\"Assasinu Credi\":{\"double\":1,\"triple\":0,\"quad\":0},\"Isgalamido\":{\"double\":1,\"triple\":0,\"quad\":0}},\"dominations\":{\"Assasinu Credi\":{\"Isgalamido\":3,\"Mal\":3},\"Isgalamido\":{\"Dono da Bola\":5,\"Mal\":3,\"Oootsimo\":3},\"Oootsimo\":{\"Assasinu Credi\":5,\"Zeh\":5}}}},\"game_08\":{\"map\":\"q3dm17\",\"total_kills\":89,\"players\":[\"Oootsimo\",\"Isgalamido\",\"Zeh\",\"Dono da Bola\",\"Mal\",\"Assasinu Credi\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":9,\"Dono da Bola\":1,\"Isgalamido\":20,\"Mal\":-3,\"Oootsimo\":15,\"Zeh\":12},\"kills_by_means\":{\"MOD_FALLING\":6,\"MOD_MACHINEGUN\":4,\"MOD_RAILGUN\":12,\"MOD_ROCKET\":18,\"MOD_ROCKET_SPLASH\":39,\"MOD_SHOTGUN\":1,\"MOD_TRIGGER_HURT\":9},\"items_by_category\":{\"ammo\":26,\"armor\":69,\"health\":13,\"powerup\":5,\"weapon\":148},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":1,\"armor\":11,\"health\":1,\"weapon\":27},\"Dono da Bola\":{\"ammo\":3,\"armor\":22,\"weapon\":26},\"Isgalamido\":{\"ammo\":2,\"armor\":8,\"health\":3,\"powerup\":2,\"weapon\":18},\"Mal\":{\"ammo\":4,\"armor\":5,\"health\":1,\"powerup\":1,\"weapon\":22},\"Oootsimo\":{\"ammo\":4,\"armor\":16,\"health\":4,\"weapon\":30},\"Zeh\":{\"ammo\":12,\"armor\":7,\"health\":4,\"powerup\":2,\"weapon\":25}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":683,\"left_at\":995,\"time_on_server\":312,\"reconnects\":0,\"present_at_end\":true},\"Dono da Bola\":{\"joined_at\":683,\"left_at\":995,\"time_on_server\":312,\"reconnects\":0,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":683,\"left_at\":995,\"time_on_server\":312,\"reconnects\":0,\"present_at_end\":true},\"Mal\":{\"joined_at\":683,\"left_at\":995,\"time_on_server\":312,\"reconnects\":0,\"present_at_end\":true},\"Oootsimo\":{\"joined_at\":683,\"left_at\":995,\"time_on_server\":312,\"reconnects\":0,\"present_at_end\":true},\"Zeh\":{\"joined_at\":683,\"left_at\":995,\"time_on_server\":312,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Assasinu Credi\":{\"Assasinu Credi\":1,\"Dono da Bola\":1,\"Isgalamido\":1,\"Mal\":6,\"Oootsimo\":2,\"Zeh\":1},\"Dono da Bola\":{\"Assasinu Credi\":1,\"Dono da Bola\":2,\"Mal\":2},\"Isgalamido\":{\"Assasinu Credi\":5,\"Dono da Bola\":4,\"Mal\":4,\"Oootsimo\":6,\"Zeh\":5},\"Mal\":{\"Mal\":1},\"Oootsimo\":{\"Assasinu Credi\":2,\"Dono da Bola\":6,\"Isgalamido\":3,\"Mal\":3,\"Oootsimo\":1,\"Zeh\":2},\"Zeh\":{\"Assasinu Credi\":6,\"Dono da Bola\":1,\"Isgalamido\":3,\"Mal\":1,\"Oootsimo\":4}},\"awards\":{\"first_blood\":\"Isgalamido\",\"streaks\":{\"Assasinu Credi\":2,\"Dono da Bola\":1,\"Isgalamido\":7,\"Oootsimo\":3,\"Zeh\":5},\"multi_kills\":{\"Isgalamido\":{\"double\":2,\"triple\":0,\"quad\":0},\"Zeh\":{\"double\":1,\"triple\":0,\"quad\":0}},\"dominations\":{\"Assasinu Credi\":{\"Mal\":6},\"Isgalamido\":{\"Assasinu Credi\":3,\"Dono da Bola\":4,\"Mal\":4,\"Oootsimo\":3,\"Zeh\":3},\"Oootsimo\":{\"Dono da Bola\":6,\"Mal\":3},\"Zeh\":{\"Assasinu Credi\":6}}}},\"game_09\":{\"map\":\"q3dm17\",\"total_kills\":67,\"players\":[\"Oootsimo\",\"Isgalamido\",\"Zeh\",\"Dono da Bola\",\"Mal\",\"Assasinu Credi\",\"Chessus!\",\"Chessus\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Chessus\":{\"display_name\":\"Chessus\",\"clean_name\":\"Chessus\"},\"Chessus!\":{\"display_name\":\"Chessus!\",\"clean_name\":\"Chessus!\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":7,\"Chessus\":8,\"Dono da Bola\":1,\"Isgalamido\":1,\"Mal\":2,\"Oootsimo\":8,\"Zeh\":12},\"kills_by_means\":{\"MOD_FALLING\":3,\"MOD_MACHINEGUN\":3,\"MOD_RAILGUN\":10,\"MOD_ROCKET\":17,\"MOD_ROCKET_SPLASH\":25,\"MOD_SHOTGUN\":1,\"MOD_TRIGGER_HURT\":8},\"items_by_category\":{\"ammo\":27,\"armor\":64,\"health\":10,\"powerup\":10,\"weapon\":149},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":6,\"armor\":9,\"health\":2,\"powerup\":2,\"weapon\":22},\"Chessus\":{\"ammo\":5,\"health\":2,\"weapon\":30},\"Dono da Bola\":{\"ammo\":2,\"weapon\":7},\"Isgalamido\":{\"weapon\":7},\"Mal\":{\"ammo\":7,\"armor\":17,\"health\":1,\"weapon\":24},\"Oootsimo\":{\"ammo\":1,\"armor\":29,\"health\":4,\"powerup\":3,\"weapon\":33},\"Zeh\":{\"ammo\":6,\"armor\":9,\"health\":1,\"powerup\":5,\"weapon\":26}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":996,\"left_at\":1312,\"time_on_server\":316,\"reconnects\":0,\"present_at_end\":true},\"Chessus\":{\"joined_at\":1167,\"left_at\":1312,\"time_on_server\":145,\"reconnects\":0,\"present_at_end\":true},\"Chessus!\":{\"joined_at\":1164,\"left_at\":1167,\"time_on_server\":3,\"reconnects\":0,\"present_at_end\":false},\"Dono da Bola\":{\"joined_at\":996,\"left_at\":1312,\"time_on_server\":266,\"reconnects\":1,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":996,\"left_at\":1109,\"time_on_server\":95,\"reconnects\":1,\"present_at_end\":false},\"Mal\":{\"joined_at\":996,\"left_at\":1312,\"time_on_server\":316,\"reconnects\":0,\"present_at_end\":true},\"Oootsimo\":{\"joined_at\":996,\"left_at\":1312,\"time_on_server\":316,\"reconnects\":0,\"present_at_end\":true},\"Zeh\":{\"joined_at\":996,\"left_at\":1312,\"time_on_server\":316,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Assasinu Credi\":{\"Assasinu Credi\":3,\"Mal\":4,\"Oootsimo\":1,\"Zeh\":3},\"Chessus\":{\"Assasinu Credi\":3,\"Oootsimo\":3,\"Zeh\":3},\"Dono da Bola\":{\"Assasinu Credi\":1,\"Dono da Bola\":1,\"Mal\":1},\"Isgalamido\":{\"Assasinu Credi\":2},\"Mal\":{\"Assasinu Credi\":1,\"Dono da Bola\":1,\"Isgalamido\":1,\"Mal\":1,\"Oootsimo\":1,\"Zeh\":2},\"Oootsimo\":{\"Dono da Bola\":1,\"Isgalamido\":1,\"Mal\":2,\"Oootsimo\":1,\"Zeh\":4},\"Zeh\":{\"Assasinu Credi\":3,\"Chessus\":2,\"Dono da Bola\":1,\"Mal\":3,\"Oootsimo\":6}},\"awards\":{\"first_blood\":\"Oootsimo\",\"streaks\":{\"Assasinu Credi\":3,\"Chessus\":6,\"Dono da Bola\":1,\"Isgalamido\":1,\"Mal\":2,\"Oootsimo\":2,\"Zeh\":6},\"multi_kills\":{},\"dominations\":{\"Assasinu Credi\":{\"Mal\":3},\"Chessus\":{\"Assasinu Credi\":3,\"Oootsimo\":3},\"Zeh\":{\"Assasinu Credi\":3,\"Mal\":3,\"Oootsimo\":4}}}},\"game_10\":{\"map\":\"Q3TOURNEY6_CTF\",\"total_kills\":60,\"players\":[\"Oootsimo\",\"Dono da Bola\",\"Zeh\",\"Chessus\",\"Mal\",\"Assasinu Credi\",\"Isgalamido\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Chessus\":{\"display_name\":\"Chessus\",\"clean_name\":\"Chessus\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":3,\"Chessus\":5,\"Dono da Bola\":3,\"Isgalamido\":5,\"Mal\":1,\"Oootsimo\":-1,\"Zeh\":7},\"kills_by_means\":{\"MOD_BFG\":2,\"MOD_BFG_SPLASH\":2,\"MOD_CRUSH\":1,\"MOD_MACHINEGUN\":1,\"MOD_RAILGUN\":7,\"MOD_ROCKET\":4,\"MOD_ROCKET_SPLASH\":1,\"MOD_TELEFRAG\":25,\"MOD_TRIGGER_HURT\":17},\"items_by_category\":{\"ammo\":10,\"armor\":2,\"health\":9,\"powerup\":2,\"weapon\":50},\"items_by_player\":{\"Assasinu Credi\":{\"health\":2,\"powerup\":1,\"weapon\":8},\"Dono da Bola\":{\"health\":1,\"powerup\":1,\"weapon\":2},\"Isgalamido\":{\"armor\":1,\"health\":1,\"weapon\":9},\"Mal\":{\"ammo\":2,\"health\":1,\"weapon\":11},\"Oootsimo\":{\"health\":1,\"weapon\":4},\"Zeh\":{\"ammo\":8,\"armor\":1,\"health\":3,\"weapon\":16}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":0,\"left_at\":148,\"time_on_server\":148,\"reconnects\":0,\"present_at_end\":false},\"Chessus\":{\"joined_at\":0,\"left_at\":137,\"time_on_server\":137,\"reconnects\":0,\"present_at_end\":false},\"Dono da Bola\":{\"joined_at\":0,\"left_at\":227,\"time_on_server\":227,\"reconnects\":0,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":13,\"left_at\":144,\"time_on_server\":131,\"reconnects\":0,\"present_at_end\":false},\"Mal\":{\"joined_at\":0,\"left_at\":154,\"time_on_server\":154,\"reconnects\":0,\"present_at_end\":false},\"Oootsimo\":{\"joined_at\":0,\"left_at\":92,\"time_on_server\":92,\"reconnects\":0,\"present_at_end\":false},\"Zeh\":{\"joined_at\":0,\"left_at\":146,\"time_on_server\":146,\"reconnects\":0,\"present_at_end\":false}},\"kill_matrix\":{\"Assasinu Credi\":{\"Chessus\":1,\"Isgalamido\":1,\"Mal\":2,\"Zeh\":1},\"Chessus\":{\"Dono da Bola\":1,\"Isgalamido\":3,\"Oootsimo\":1,\"Zeh\":1},\"Dono da Bola\":{\"Assasinu Credi\":1,\"Chessus\":3,\"Oootsimo\":1},\"Isgalamido\":{\"Assasinu Credi\":3,\"Isgalamido\":1,\"Mal\":6},\"Mal\":{\"Chessus\":2,\"Isgalamido\":1,\"Oootsimo\":2,\"Zeh\":1},\"Oootsimo\":{\"Isgalamido\":1},\"Zeh\":{\"Assasinu Credi\":2,\"Chessus\":2,\"Isgalamido\":2,\"Mal\":1,\"Oootsimo\":2}},\"awards\":{\"first_blood\":\"Mal\",\"streaks\":{\"Assasinu Credi\":1,\"Chessus\":1,\"Dono da Bola\":3,\"Isgalamido\":3,\"Mal\":1,\"Oootsimo\":1,\"Zeh\":4},\"multi_kills\":{\"Dono da Bola\":{\"double\":1,\"triple\":0,\"quad\":0},\"Zeh\":{\"double\":1,\"triple\":0,\"quad\":0}},\"dominations\":{\"Chessus\":{\"Isgalamido\":3},\"Dono da Bola\":{\"Chessus\":3},\"Isgalamido\":{\"Assasinu Credi\":3,\"Mal\":3}}}},\"game_11\":{\"map\":\"Q3TOURNEY6_CTF\",\"total_kills\":20,\"players\":[\"Dono da Bola\",\"Isgalamido\",\"Zeh\",\"Oootsimo\",\"Chessus\",\"Assasinu Credi\",\"UnnamedPlayer\",\"Mal\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Chessus\":{\"display_name\":\"Chessus\",\"clean_name\":\"Chessus\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"UnnamedPlayer\":{\"display_name\":\"UnnamedPlayer\",\"clean_name\":\"UnnamedPlayer\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":-3,\"Dono da Bola\":-2,\"Isgalamido\":4,\"Oootsimo\":4},\"kills_by_means\":{\"MOD_BFG_SPLASH\":3,\"MOD_CRUSH\":1,\"MOD_MACHINEGUN\":1,\"MOD_RAILGUN\":4,\"MOD_ROCKET_SPLASH\":4,\"MOD_TRIGGER_HURT\":7},\"items_by_category\":{\"ammo\":10,\"armor\":3,\"health\":6,\"weapon\":62},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":2,\"weapon\":7},\"Chessus\":{\"ammo\":1,\"weapon\":10},\"Dono da Bola\":{\"armor\":1,\"health\":1,\"weapon\":10},\"Isgalamido\":{\"ammo\":4,\"armor\":2,\"weapon\":11},\"Mal\":{\"weapon\":3},\"Oootsimo\":{\"health\":2,\"weapon\":11},\"Zeh\":{\"ammo\":3,\"health\":3,\"weapon\":10}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":69,\"left_at\":153,\"time_on_server\":84,\"reconnects\":0,\"present_at_end\":true},\"Chessus\":{\"joined_at\":65,\"left_at\":153,\"time_on_server\":88,\"reconnects\":0,\"present_at_end\":true},\"Dono da Bola\":{\"joined_at\":0,\"left_at\":153,\"time_on_server\":153,\"reconnects\":0,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":26,\"left_at\":153,\"time_on_server\":127,\"reconnects\":0,\"present_at_end\":true},\"Mal\":{\"joined_at\":118,\"left_at\":153,\"time_on_server\":35,\"reconnects\":0,\"present_at_end\":true},\"Oootsimo\":{\"joined_at\":62,\"left_at\":153,\"time_on_server\":91,\"reconnects\":0,\"present_at_end\":true},\"UnnamedPlayer\":{\"joined_at\":115,\"left_at\":118,\"time_on_server\":3,\"reconnects\":0,\"present_at_end\":false},\"Zeh\":{\"joined_at\":60,\"left_at\":153,\"time_on_server\":93,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Dono da Bola\":{\"Oootsimo\":1},\"Isgalamido\":{\"Chessus\":3,\"Isgalamido\":1,\"Mal\":1,\"Zeh\":2},\"Oootsimo\":{\"Assasinu Credi\":1,\"Dono da Bola\":2,\"Isgalamido\":1}},\"awards\":{\"first_blood\":\"Oootsimo\",\"streaks\":{\"Dono da Bola\":1,\"Isgalamido\":4,\"Oootsimo\":2},\"multi_kills\":{},\"dominations\":{\"Isgalamido\":{\"Chessus\":3}}},\"teams\":{\"players\":{\"Assasinu Credi\":\"red\",\"Chessus\":\"blue\",\"Dono da Bola\":\"red\",\"Isgalamido\":\"red\",\"Mal\":\"blue\",\"Oootsimo\":\"blue\",\"UnnamedPlayer\":\"spectator\",\"Zeh\":\"blue\"},\"switches\":{\"Assasinu Credi\":1,\"Chessus\":1,\"Dono da Bola\":1,\"Isgalamido\":1,\"Mal\":1,\"Oootsimo\":1,\"Zeh\":1},\"kills\":{\"blue\":4,\"red\":7},\"team_kills\":{},\"scores\":{}},\"ctf\":{\"players\":{\"Assasinu Credi\":{\"pickups\":1,\"captures\":0,\"returns\":0,\"carrier_kills\":0},\"Chessus\":{\"pickups\":4,\"captures\":3,\"returns\":0,\"carrier_kills\":0},\"Dono da Bola\":{\"pickups\":2,\"captures\":0,\"returns\":0,\"carrier_kills\":0},\"Isgalamido\":{\"pickups\":0,\"captures\":0,\"returns\":0,\"carrier_kills\":3},\"Mal\":{\"pickups\":2,\"captures\":0,\"returns\":0,\"carrier_kills\":0},\"Oootsimo\":{\"pickups\":1,\"captures\":1,\"returns\":2,\"carrier_kills\":2},\"Zeh\":{\"pickups\":1,\"captures\":0,\"returns\":0,\"carrier_kills\":0}},\"teams\":{\"blue\":{\"pickups\":8,\"captures\":4,\"returns\":2,\"carrier_kills\":2},\"red\":{\"pickups\":3,\"captures\":0,\"returns\":0,\"carrier_kills\":3}}}},\"game_12\":{\"map\":\"Q3TOURNEY6_CTF\",\"total_kills\":160,\"players\":[\"Isgalamido\",\"Dono da Bola\",\"Zeh\",\"Oootsimo\",\"Chessus\",\"Assasinu Credi\",\"Mal\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Chessus\":{\"display_name\":\"Chessus\",\"clean_name\":\"Chessus\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":18,\"Chessus\":12,\"Dono da Bola\":3,\"Isgalamido\":24,\"Mal\":-7,\"Oootsimo\":12,\"Zeh\":11},\"kills_by_means\":{\"MOD_BFG\":8,\"MOD_BFG_SPLASH\":8,\"MOD_FALLING\":2,\"MOD_MACHINEGUN\":7,\"MOD_RAILGUN\":38,\"MOD_ROCKET\":25,\"MOD_ROCKET_SPLASH\":35,\"MOD_TRIGGER_HURT\":37},\"items_by_category\":{\"ammo\":42,\"armor\":7,\"health\":36,\"weapon\":341},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":10,\"health\":2,\"weapon\":46},\"Chessus\":{\"ammo\":1,\"armor\":2,\"health\":3,\"weapon\":61},\"Dono da Bola\":{\"ammo\":1,\"armor\":1,\"health\":3,\"weapon\":60},\"Isgalamido\":{\"ammo\":4,\"armor\":2,\"health\":14,\"weapon\":38},\"Mal\":{\"ammo\":6,\"health\":2,\"weapon\":40},\"Oootsimo\":{\"ammo\":9,\"armor\":1,\"health\":4,\"weapon\":48},\"Zeh\":{\"ammo\":11,\"armor\":1,\"health\":8,\"weapon\":48}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":153,\"left_at\":628,\"time_on_server\":475,\"reconnects\":0,\"present_at_end\":true},\"Chessus\":{\"joined_at\":153,\"left_at\":628,\"time_on_server\":475,\"reconnects\":0,\"present_at_end\":true},\"Dono da Bola\":{\"joined_at\":153,\"left_at\":628,\"time_on_server\":475,\"reconnects\":0,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":153,\"left_at\":628,\"time_on_server\":475,\"reconnects\":0,\"present_at_end\":true},\"Mal\":{\"joined_at\":153,\"left_at\":628,\"time_on_server\":475,\"reconnects\":0,\"present_at_end\":true},\"Oootsimo\":{\"joined_at\":153,\"left_at\":628,\"time_on_server\":475,\"reconnects\":0,\"present_at_end\":true},\"Zeh\":{\"joined_at\":153,\"left_at\":628,\"time_on_server\":475,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Assasinu Credi\":{\"Assasinu Credi\":2,\"Chessus\":11,\"Mal\":2,\"Oootsimo\":2,\"Zeh\":6},\"Chessus\":{\"Assasinu Credi\":6,\"Chessus\":1,\"Dono da Bola\":3,\"Isgalamido\":7},\"Dono da Bola\":{\"Chessus\":4,\"Mal\":1,\"Oootsimo\":3,\"Zeh\":3},\"Isgalamido\":{\"Chessus\":4,\"Isgalamido\":2,\"Mal\":8,\"Oootsimo\":8,\"Zeh\":4},\"Mal\":{\"Assasinu Credi\":1,\"Dono da Bola\":4,\"Isgalamido\":2,\"Mal\":1},\"Oootsimo\":{\"Assasinu Credi\":4,\"Dono da Bola\":8,\"Isgalamido\":9,\"Oootsimo\":1},\"Zeh\":{\"Assasinu Credi\":3,\"Dono da Bola\":8,\"Isgalamido\":1,\"Zeh\":2}},\"awards\":{\"first_blood\":\"Assasinu Credi\",\"streaks\":{\"Assasinu Credi\":5,\"Chessus\":3,\"Dono da Bola\":2,\"Isgalamido\":3,\"Mal\":1,\"Oootsimo\":6,\"Zeh\":2},\"multi_kills\":{\"Assasinu Credi\":{\"double\":1,\"triple\":1,\"quad\":0},\"Isgalamido\":{\"double\":1,\"triple\":0,\"quad\":0},\"Oootsimo\":{\"double\":3,\"triple\":0,\"quad\":0}},\"dominations\":{\"Assasinu Credi\":{\"Chessus\":3,\"Zeh\":3},\"Isgalamido\":{\"Mal\":6,\"Zeh\":3},\"Oootsimo\":{\"Assasinu Credi\":3,\"Dono da Bola\":5,\"Isgalamido\":4},\"Zeh\":{\"Dono da Bola\":3}}},\"teams\":{\"players\":{\"Assasinu Credi\":\"red\",\"Chessus\":\"blue\",\"Dono da Bola\":\"red\",\"Isgalamido\":\"red\",\"Mal\":\"blue\",\"Oootsimo\":\"blue\",\"Zeh\":\"blue\"},\"switches\":{},\"kills\":{\"blue\":56,\"red\":56},\"team_kills\":{},\"scores\":{\"blue\":6,\"red\":8}},\"ctf\":{\"players\":{\"Assasinu Credi\":{\"pickups\":4,\"captures\":2,\"returns\":3,\"carrier_kills\":3},\"Chessus\":{\"pickups\":6,\"captures\":2,\"returns\":0,\"carrier_kills\":2},\"Dono da Bola\":{\"pickups\":18,\"captures\":3,\"returns\":4,\"carrier_kills\":1},\"Isgalamido\":{\"pickups\":4,\"captures\":2,\"returns\":5,\"carrier_kills\":11},\"Mal\":{\"pickups\":9,\"captures\":0,\"returns\":3,\"carrier_kills\":1},\"Oootsimo\":{\"pickups\":11,\"captures\":1,\"returns\":4,\"carrier_kills\":5},\"Zeh\":{\"pickups\":7,\"captures\":3,\"returns\":3,\"carrier_kills\":4}},\"teams\":{\"blue\":{\"pickups\":33,\"captures\":6,\"returns\":10,\"carrier_kills\":12},\"red\":{\"pickups\":26,\"captures\":7,\"returns\":12,\"carrier_kills\":15}}}},\"game_13\":{\"map\":\"Q3TOURNEY6_CTF\",\"total_kills\":6,\"players\":[\"Isgalamido\",\"Dono da Bola\",\"Zeh\",\"Oootsimo\",\"Chessus\",\"Assasinu Credi\",\"Mal\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Chessus\":{\"display_name\":\"Chessus\",\"clean_name\":\"Chessus\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Dono da Bola\":-1,\"Isgalamido\":-1,\"Oootsimo\":1,\"Zeh\":2},\"kills_by_means\":{\"MOD_BFG\":1,\"MOD_BFG_SPLASH\":1,\"MOD_ROCKET\":1,\"MOD_ROCKET_SPLASH\":1,\"MOD_TRIGGER_HURT\":2},\"items_by_category\":{\"ammo\":4,\"health\":3,\"weapon\":15},\"items_by_player\":{\"Assasinu Credi\":{\"weapon\":1},\"Chessus\":{\"weapon\":6},\"Isgalamido\":{\"weapon\":2},\"Mal\":{\"weapon\":1},\"Oootsimo\":{\"ammo\":4,\"health\":1,\"weapon\":2},\"Zeh\":{\"health\":2,\"weapon\":3}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":628,\"left_at\":663,\"time_on_server\":35,\"reconnects\":0,\"present_at_end\":true},\"Chessus\":{\"joined_at\":628,\"left_at\":663,\"time_on_server\":35,\"reconnects\":0,\"present_at_end\":true},\"Dono da Bola\":{\"joined_at\":628,\"left_at\":663,\"time_on_server\":35,\"reconnects\":0,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":628,\"left_at\":663,\"time_on_server\":35,\"reconnects\":0,\"present_at_end\":true},\"Mal\":{\"joined_at\":628,\"left_at\":663,\"time_on_server\":35,\"reconnects\":0,\"present_at_end\":true},\"Oootsimo\":{\"joined_at\":628,\"left_at\":663,\"time_on_server\":35,\"reconnects\":0,\"present_at_end\":true},\"Zeh\":{\"joined_at\":628,\"left_at\":663,\"time_on_server\":35,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Oootsimo\":{\"Assasinu Credi\":1,\"Oootsimo\":1},\"Zeh\":{\"Assasinu Credi\":1,\"Dono da Bola\":1}},\"awards\":{\"first_blood\":\"Oootsimo\",\"streaks\":{\"Oootsimo\":1,\"Zeh\":2},\"multi_kills\":{},\"dominations\":{}},\"teams\":{\"players\":{\"Assasinu Credi\":\"red\",\"Chessus\":\"blue\",\"Dono da Bola\":\"red\",\"Isgalamido\":\"red\",\"Mal\":\"blue\",\"Oootsimo\":\"blue\",\"Zeh\":\"blue\"},\"switches\":{},\"kills\":{\"blue\":3},\"team_kills\":{},\"scores\":{}},\"ctf\":{\"players\":{\"Oootsimo\":{\"pickups\":1,\"captures\":1,\"returns\":0,\"carrier_kills\":0}},\"teams\":{\"blue\":{\"pickups\":1,\"captures\":1,\"returns\":0,\"carrier_kills\":0}}}},\"game_14\":{\"map\":\"Q3TOURNEY6_CTF\",\"total_kills\":122,\"players\":[\"Isgalamido\",\"Dono da Bola\",\"Zeh\",\"Oootsimo\",\"Chessus\",\"Assasinu Credi\",\"Mal\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Chessus\":{\"display_name\":\"Chessus\",\"clean_name\":\"Chessus\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":3,\"Chessus\":7,\"Dono da Bola\":1,\"Isgalamido\":22,\"Mal\":-5,\"Oootsimo\":9,\"Zeh\":4},\"kills_by_means\":{\"MOD_BFG\":5,\"MOD_BFG_SPLASH\":10,\"MOD_FALLING\":5,\"MOD_MACHINEGUN\":4,\"MOD_RAILGUN\":20,\"MOD_ROCKET\":23,\"MOD_ROCKET_SPLASH\":24,\"MOD_TRIGGER_HURT\":31},\"items_by_category\":{\"ammo\":32,\"armor\":8,\"health\":23,\"weapon\":247},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":2,\"health\":1,\"weapon\":35},\"Chessus\":{\"ammo\":3,\"health\":3,\"weapon\":49},\"Dono da Bola\":{\"ammo\":5,\"armor\":5,\"health\":6,\"weapon\":32},\"Isgalamido\":{\"ammo\":3,\"armor\":1,\"health\":7,\"weapon\":46},\"Mal\":{\"armor\":1,\"health\":3,\"weapon\":20},\"Oootsimo\":{\"ammo\":8,\"health\":2,\"weapon\":32},\"Zeh\":{\"ammo\":11,\"armor\":1,\"health\":1,\"weapon\":33}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":664,\"left_at\":1013,\"time_on_server\":349,\"reconnects\":0,\"present_at_end\":true},\"Chessus\":{\"joined_at\":664,\"left_at\":1011,\"time_on_server\":347,\"reconnects\":0,\"present_at_end\":false},\"Dono da Bola\":{\"joined_at\":664,\"left_at\":992,\"time_on_server\":328,\"reconnects\":0,\"present_at_end\":false},\"Isgalamido\":{\"joined_at\":664,\"left_at\":985,\"time_on_server\":321,\"reconnects\":0,\"present_at_end\":false},\"Mal\":{\"joined_at\":664,\"left_at\":1006,\"time_on_server\":342,\"reconnects\":0,\"present_at_end\":false},\"Oootsimo\":{\"joined_at\":664,\"left_at\":1013,\"time_on_server\":349,\"reconnects\":0,\"present_at_end\":false},\"Zeh\":{\"joined_at\":664,\"left_at\":1013,\"time_on_server\":349,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Assasinu Credi\":{\"Assasinu Credi\":4,\"Chessus\":1,\"Mal\":3,\"Oootsimo\":1,\"Zeh\":3},\"Chessus\":{\"Assasinu Credi\":2,\"Dono da Bola\":5,\"Isgalamido\":3},\"Dono da Bola\":{\"Chessus\":1,\"Dono da Bola\":1,\"Mal\":3,\"Oootsimo\":2,\"Zeh\":2},\"Isgalamido\":{\"Chessus\":9,\"Mal\":3,\"Oootsimo\":5,\"Zeh\":8},\"Mal\":{\"Assasinu Credi\":1,\"Dono da Bola\":1,\"Isgalamido\":1,\"Mal\":3},\"Oootsimo\":{\"Assasinu Credi\":2,\"Dono da Bola\":9,\"Isgalamido\":1},\"Zeh\":{\"Assasinu Credi\":5,\"Dono da Bola\":2,\"Isgalamido\":4,\"Zeh\":1}},\"awards\":{\"first_blood\":\"Isgalamido\",\"streaks\":{\"Assasinu Credi\":2,\"Chessus\":3,\"Dono da Bola\":3,\"Isgalamido\":8,\"Mal\":2,\"Oootsimo\":6,\"Zeh\":2},\"multi_kills\":{\"Assasinu Credi\":{\"double\":1,\"triple\":0,\"quad\":0},\"Isgalamido\":{\"double\":2,\"triple\":0,\"quad\":0},\"Oootsimo\":{\"double\":1,\"triple\":0,\"quad\":0},\"Zeh\":{\"double\":1,\"triple\":0,\"quad\":0}},\"dominations\":{\"Assasinu Credi\":{\"Zeh\":3},\"Chessus\":{\"Dono da Bola\":3},\"Isgalamido\":{\"Chessus\":4,\"Mal\":3,\"Oootsimo\":3,\"Zeh\":4},\"Oootsimo\":{\"Dono da Bola\":7},\"Zeh\":{\"Assasinu Credi\":4}}},\"teams\":{\"players\":{\"Assasinu Credi\":\"red\",\"Chessus\":\"blue\",\"Dono da Bola\":\"red\",\"Isgalamido\":\"red\",\"Mal\":\"blue\",\"Oootsimo\":\"blue\",\"Zeh\":\"blue\"},\"switches\":{},\"kills\":{\"blue\":36,\"red\":41},\"team_kills\":{},\"scores\":{\"blue\":8,\"red\":2}},\"ctf\":{\"players\":{\"Assasinu Credi\":{\"pickups\":3,\"captures\":0,\"returns\":2,\"carrier_kills\":2},\"Chessus\":{\"pickups\":3,\"captures\":0,\"returns\":4,\"carrier_kills\":6},\"Dono da Bola\":{\"pickups\":19,\"captures\":1,\"returns\":1,\"carrier_kills\":2},\"Isgalamido\":{\"pickups\":2,\"captures\":1,\"returns\":7,\"carrier_kills\":10},\"Mal\":{\"pickups\":3,\"captures\":1,\"returns\":3,\"carrier_kills\":1},\"Oootsimo\":{\"pickups\":6,\"captures\":2,\"returns\":8,\"carrier_kills\":8},\"Zeh\":{\"pickups\":13,\"captures\":4,\"returns\":2,\"carrier_kills\":2}},\"teams\":{\"blue\":{\"pickups\":25,\"captures\":7,\"returns\":17,\"carrier_kills\":17},\"red\":{\"pickups\":24,\"captures\":2,\"returns\":10,\"carrier_kills\":14}}}},\"game_15\":{\"map\":\"Q3TOURNEY6_CTF\",\"total_kills\":3,\"players\":[\"Zeh\",\"Assasinu Credi\",\"Dono da Bola\",\"Fasano Again\",\"Isgalamido\",\"Oootsimo\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Fasano Again\":{\"display_name\":\"Fasano Again\",\"clean_name\":\"Fasano Again\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Zeh\":-3},\"kills_by_means\":{\"MOD_TRIGGER_HURT\":3},\"items_by_category\":{\"ammo\":4,\"armor\":1,\"weapon\":6},\"items_by_player\":{\"Zeh\":{\"ammo\":4,\"armor\":1,\"weapon\":6}},\"chat\":[{\"time\":58881,\"player\":\"Oootsimo\",\"message\":\"team red\"},{\"time\":58886,\"player\":\"Isgalamido\",\"message\":\"team blue\"}],\"connections\":{\"Assasinu Credi\":{\"joined_at\":1013,\"left_at\":58887,\"time_on_server\":50,\"reconnects\":1,\"present_at_end\":true},\"Dono da Bola\":{\"joined_at\":58866,\"left_at\":58887,\"time_on_server\":21,\"reconnects\":0,\"present_at_end\":true},\"Fasano Again\":{\"joined_at\":58871,\"left_at\":58874,\"time_on_server\":3,\"reconnects\":0,\"present_at_end\":false},\"Isgalamido\":{\"joined_at\":58873,\"left_at\":58887,\"time_on_server\":14,\"reconnects\":0,\"present_at_end\":true},\"Oootsimo\":{\"joined_at\":58874,\"left_at\":58887,\"time_on_server\":13,\"reconnects\":0,\"present_at_end\":true},\"Zeh\":{\"joined_at\":1013,\"left_at\":1070,\"time_on_server\":57,\"reconnects\":0,\"present_at_end\":false}},\"kill_matrix\":{},\"awards\":{\"first_blood\":\"\",\"streaks\":{},\"multi_kills\":{},\"dominations\":{}},\"teams\":{\"players\":{\"Assasinu Credi\":\"spectator\",\"Dono da Bola\":\"spectator\",\"Fasano Again\":\"spectator\",\"Isgalamido\":\"spectator\",\"Oootsimo\":\"spectator\",\"Zeh\":\"blue\"},\"switches\":{\"Assasinu Credi\":1},\"kills\":{},\"team_kills\":{},\"scores\":{\"blue\":1,\"red\":0}},\"ctf\":{\"players\":{\"Zeh\":{\"pickups\":2,\"captures\":1,\"returns\":0,\"carrier_kills\":0}},\"teams\":{\"blue\":{\"pickups\":2,\"captures\":1,\"returns\":0,\"carrier_kills\":0}}}},\"game_16\":{\"map\":\"Q3TOURNEY6_CTF\",\"total_kills\":0,\"players\":[\"Dono da Bola\",\"Oootsimo\",\"Isgalamido\",\"Assasinu Credi\",\"Zeh\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{},\"kills_by_means\":{},\"items_by_category\":{\"weapon\":3},\"items_by_player\":{\"Isgalamido\":{\"weapon\":2},\"Oootsimo\":{\"weapon\":1}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":58887,\"left_at\":58899,\"time_on_server\":12,\"reconnects\":0,\"present_at_end\":true},\"Dono da Bola\":{\"joined_at\":58887,\"left_at\":58899,\"time_on_server\":12,\"reconnects\":0,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":58887,\"left_at\":58899,\"time_on_server\":12,\"reconnects\":0,\"present_at_end\":true},\"Oootsimo\":{\"joined_at\":58887,\"left_at\":58899,\"time_on_server\":12,\"reconnects\":0,\"present_at_end\":true},\"Zeh\":{\"joined_at\":58896,\"left_at\":58899,\"time_on_server\":3,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{},\"awards\":{\"first_blood\":\"\",\"streaks\":{},\"multi_kills\":{},\"dominations\":{}},\"teams\":{\"players\":{\"Assasinu Credi\":\"spectator\",\"Dono da Bola\":\"spectator\",\"Isgalamido\":\"red\",\"Oootsimo\":\"blue\",\"Zeh\":\"spectator\"},\"switches\":{\"Isgalamido\":1,\"Oootsimo\":1},\"kills\":{},\"team_kills\":{},\"scores\":{}},\"ctf\":{\"players\":{},\"teams\":{}}},\"game_17\":{\"map\":\"q3dm17\",\"total_kills\":13,\"players\":[\"Dono da Bola\",\"Oootsimo\",\"Isgalamido\",\"Assasinu Credi\",\"Zeh\",\"UnnamedPlayer\",\"Mal\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"UnnamedPlayer\":{\"display_name\":\"UnnamedPlayer\",\"clean_name\":\"UnnamedPlayer\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":-3,\"Dono da Bola\":-2,\"Mal\":-1},\"kills_by_means\":{\"MOD_FALLING\":3,\"MOD_RAILGUN\":2,\"MOD_ROCKET_SPLASH\":2,\"MOD_TRIGGER_HURT\":6},\"items_by_category\":{\"ammo\":8,\"armor\":27,\"health\":6,\"powerup\":3,\"weapon\":33},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":1,\"armor\":2,\"powerup\":1,\"weapon\":4},\"Dono da Bola\":{\"ammo\":1,\"weapon\":2},\"Isgalamido\":{\"ammo\":2,\"powerup\":1,\"weapon\":4},\"Mal\":{\"ammo\":1,\"armor\":3,\"powerup\":1,\"weapon\":6},\"Oootsimo\":{\"ammo\":2,\"armor\":14,\"health\":3,\"weapon\":9},\"Zeh\":{\"ammo\":1,\"armor\":8,\"health\":3,\"weapon\":8}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":0,\"left_at\":113,\"time_on_server\":113,\"reconnects\":0,\"present_at_end\":true},\"Dono da Bola\":{\"joined_at\":0,\"left_at\":113,\"time_on_server\":113,\"reconnects\":0,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":0,\"left_at\":113,\"time_on_server\":108,\"reconnects\":1,\"present_at_end\":true},\"Mal\":{\"joined_at\":51,\"left_at\":113,\"time_on_server\":62,\"reconnects\":0,\"present_at_end\":true},\"Oootsimo\":{\"joined_at\":0,\"left_at\":113,\"time_on_server\":113,\"reconnects\":0,\"present_at_end\":true},\"UnnamedPlayer\":{\"joined_at\":47,\"left_at\":51,\"time_on_server\":4,\"reconnects\":0,\"present_at_end\":false},\"Zeh\":{\"joined_at\":0,\"left_at\":113,\"time_on_server\":113,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Isgalamido\":{\"Assasinu Credi\":1},\"Oootsimo\":{\"Oootsimo\":1,\"Zeh\":1},\"Zeh\":{\"Oootsimo\":1}},\"awards\":{\"first_blood\":\"Isgalamido\",\"streaks\":{\"Isgalamido\":1,\"Oootsimo\":1,\"Zeh\":1},\"multi_kills\":{},\"dominations\":{}},\"teams\":{\"players\":{\"Assasinu Credi\":\"blue\",\"Dono da Bola\":\"red\",\"Isgalamido\":\"red\",\"Mal\":\"blue\",\"Oootsimo\":\"blue\",\"UnnamedPlayer\":\"spectator\",\"Zeh\":\"red\"},\"switches\":{\"Assasinu Credi\":1,\"Dono da Bola\":1,\"Isgalamido\":2,\"Mal\":1,\"Zeh\":1},\"kills\":{\"blue\":1,\"red\":2},\"team_kills\":{},\"scores\":{}},\"ctf\":{\"players\":{},\"teams\":{}}},\"game_18\":{\"map\":\"q3dm17\",\"total_kills\":7,\"players\":[\"Dono da Bola\",\"Oootsimo\",\"Isgalamido\",\"Assasinu Credi\",\"Zeh\",\"Mal\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":2,\"Dono da Bola\":-1,\"Isgalamido\":1,\"Mal\":-1,\"Zeh\":2},\"kills_by_means\":{\"MOD_FALLING\":1,\"MOD_ROCKET\":1,\"MOD_ROCKET_SPLASH\":4,\"MOD_TRIGGER_HURT\":1},\"items_by_category\":{\"ammo\":4,\"armor\":5,\"health\":1,\"weapon\":11},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":1,\"armor\":4,\"weapon\":2},\"Dono da Bola\":{\"weapon\":1},\"Isgalamido\":{\"weapon\":2},\"Mal\":{\"ammo\":1,\"weapon\":3},\"Oootsimo\":{\"health\":1,\"weapon\":1},\"Zeh\":{\"ammo\":2,\"armor\":1,\"weapon\":2}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":0,\"left_at\":35,\"time_on_server\":35,\"reconnects\":0,\"present_at_end\":true},\"Dono da Bola\":{\"joined_at\":0,\"left_at\":35,\"time_on_server\":27,\"reconnects\":1,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":0,\"left_at\":35,\"time_on_server\":28,\"reconnects\":1,\"present_at_end\":true},\"Mal\":{\"joined_at\":0,\"left_at\":35,\"time_on_server\":35,\"reconnects\":0,\"present_at_end\":true},\"Oootsimo\":{\"joined_at\":0,\"left_at\":35,\"time_on_server\":35,\"reconnects\":0,\"present_at_end\":true},\"Zeh\":{\"joined_at\":0,\"left_at\":35,\"time_on_server\":35,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Assasinu Credi\":{\"Oootsimo\":1,\"Zeh\":1},\"Isgalamido\":{\"Mal\":1},\"Zeh\":{\"Assasinu Credi\":1,\"Isgalamido\":1}},\"awards\":{\"first_blood\":\"Zeh\",\"streaks\":{\"Assasinu Credi\":2,\"Isgalamido\":1,\"Zeh\":2},\"multi_kills\":{},\"dominations\":{}}},\"game_19\":{\"map\":\"q3dm17\",\"total_kills\":95,\"players\":[\"Isgalamido\",\"Oootsimo\",\"Dono da Bola\",\"Assasinu Credi\",\"Zeh\",\"Mal\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":8,\"Dono da Bola\":12,\"Isgalamido\":13,\"Mal\":2,\"Oootsimo\":10,\"Zeh\":20},\"kills_by_means\":{\"MOD_FALLING\":1,\"MOD_MACHINEGUN\":7,\"MOD_RAILGUN\":10,\"MOD_ROCKET\":27,\"MOD_ROCKET_SPLASH\":32,\"MOD_SHOTGUN\":6,\"MOD_TRIGGER_HURT\":12},\"items_by_category\":{\"ammo\":29,\"armor\":70,\"health\":15,\"powerup\":15,\"weapon\":163},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":2,\"armor\":11,\"health\":4,\"powerup\":1,\"weapon\":24},\"Dono da Bola\":{\"ammo\":1,\"armor\":14,\"health\":4,\"powerup\":4,\"weapon\":22},\"Isgalamido\":{\"ammo\":5,\"armor\":5,\"powerup\":4,\"weapon\":22},\"Mal\":{\"ammo\":5,\"armor\":12,\"health\":1,\"weapon\":27},\"Oootsimo\":{\"ammo\":7,\"armor\":22,\"health\":5,\"powerup\":1,\"weapon\":36},\"Zeh\":{\"ammo\":9,\"armor\":6,\"health\":1,\"powerup\":5,\"weapon\":32}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":35,\"left_at\":370,\"time_on_server\":335,\"reconnects\":0,\"present_at_end\":true},\"Dono da Bola\":{\"joined_at\":35,\"left_at\":370,\"time_on_server\":335,\"reconnects\":0,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":35,\"left_at\":370,\"time_on_server\":335,\"reconnects\":0,\"present_at_end\":true},\"Mal\":{\"joined_at\":35,\"left_at\":370,\"time_on_server\":335,\"reconnects\":0,\"present_at_end\":true},\"Oootsimo\":{\"joined_at\":35,\"left_at\":370,\"time_on_server\":335,\"reconnects\":0,\"present_at_end\":true},\"Zeh\":{\"joined_at\":35,\"left_at\":370,\"time_on_server\":335,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Assasinu Credi\":{\"Assasinu Credi\":1,\"Dono da Bola\":1,\"Isgalamido\":1,\"Mal\":3,\"Oootsimo\":2,\"Zeh\":4},\"Dono da Bola\":{\"Assasinu Credi\":4,\"Dono da Bola\":2,\"Isgalamido\":2,\"Oootsimo\":5,\"Zeh\":2},\"Isgalamido\":{\"Assasinu Credi\":1,\"Dono da Bola\":1,\"Isgalamido\":1,\"Mal\":3,\"Oootsimo\":2,\"Zeh\":7},\"Mal\":{\"Assasinu Credi\":2,\"Dono da Bola\":4,\"Isgalamido\":1,\"Zeh\":1},\"Oootsimo\":{\"Assasinu Credi\":1,\"Dono da Bola\":1,\"Isgalamido\":2,\"Mal\":4,\"Zeh\":3},\"Zeh\":{\"Assasinu Credi\":5,\"Dono da Bola\":5,\"Isgalamido\":4,\"Mal\":3,\"Oootsimo\":4}},\"awards\":{\"first_blood\":\"Zeh\",\"streaks\":{\"Assasinu Credi\":4,\"Dono da Bola\":3,\"Isgalamido\":3,\"Mal\":1,\"Oootsimo\":4,\"Zeh\":4},\"multi_kills\":{\"Assasinu Credi\":{\"double\":1,\"triple\":0,\"quad\":0},\"Zeh\":{\"double\":2,\"triple\":0,\"quad\":0}},\"dominations\":{\"Assasinu Credi\":{\"Mal\":3},\"Dono da Bola\":{\"Assasinu Credi\":4,\"Oootsimo\":5},\"Isgalamido\":{\"Zeh\":6},\"Mal\":{\"Dono da Bola\":4},\"Oootsimo\":{\"Mal\":4},\"Zeh\":{\"Dono da Bola\":3,\"Isgalamido\":3,\"Oootsimo\":3}}}},\"game_20\":{\"map\":\"q3dm17\",\"total_kills\":3,\"players\":[\"Isgalamido\",\"Oootsimo\",\"Dono da Bola\",\"Assasinu Credi\",\"Zeh\",\"Mal\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Dono da Bola\":1,\"Oootsimo\":1},\"kills_by_means\":{\"MOD_ROCKET\":1,\"MOD_ROCKET_SPLASH\":2},\"items_by_category\":{\"ammo\":2,\"armor\":5,\"health\":1,\"weapon\":13},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":1,\"health\":1,\"weapon\":1},\"Dono da Bola\":{\"armor\":4,\"weapon\":2},\"Isgalamido\":{\"weapon\":5},\"Mal\":{\"weapon\":3},\"Oootsimo\":{\"ammo\":1,\"armor\":1,\"weapon\":2}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":370,\"left_at\":394,\"time_on_server\":24,\"reconnects\":0,\"present_at_end\":true},\"Dono da Bola\":{\"joined_at\":370,\"left_at\":394,\"time_on_server\":24,\"reconnects\":0,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":370,\"left_at\":394,\"time_on_server\":24,\"reconnects\":0,\"present_at_end\":true},\"Mal\":{\"joined_at\":370,\"left_at\":394,\"time_on_server\":24,\"reconnects\":0,\"present_at_end\":true},\"Oootsimo\":{\"joined_at\":370,\"left_at\":394,\"time_on_server\":24,\"reconnects\":0,\"present_at_end\":true},\"Zeh\":{\"joined_at\":370,\"left_at\":394,\"time_on_server\":24,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Dono da Bola\":{\"Dono da Bola\":1,\"Zeh\":1},\"Oootsimo\":{\"Assasinu Credi\":1}},\"awards\":{\"first_blood\":\"Dono da Bola\",\"streaks\":{\"Dono da Bola\":1,\"Oootsimo\":1},\"multi_kills\":{},\"dominations\":{}}},\"game_21\":{\"map\":\"q3dm17\",\"total_kills\":131,\"players\":[\"Isgalamido\",\"Oootsimo\",\"Dono da Bola\",\"Assasinu Credi\",\"Zeh\",\"Mal\"],\"names\":{\"Assasinu Credi\":{\"display_name\":\"Assasinu Credi\",\"clean_name\":\"Assasinu Credi\"},\"Dono da Bola\":{\"display_name\":\"Dono da Bola\",\"clean_name\":\"Dono da Bola\"},\"Isgalamido\":{\"display_name\":\"Isgalamido\",\"clean_name\":\"Isgalamido\"},\"Mal\":{\"display_name\":\"Mal\",\"clean_name\":\"Mal\"},\"Oootsimo\":{\"display_name\":\"Oootsimo\",\"clean_name\":\"Oootsimo\"},\"Zeh\":{\"display_name\":\"Zeh\",\"clean_name\":\"Zeh\"}},\"kills\":{\"Assasinu Credi\":16,\"Dono da Bola\":12,\"Isgalamido\":17,\"Mal\":6,\"Oootsimo\":21,\"Zeh\":19},\"kills_by_means\":{\"MOD_FALLING\":3,\"MOD_MACHINEGUN\":4,\"MOD_RAILGUN\":9,\"MOD_ROCKET\":37,\"MOD_ROCKET_SPLASH\":60,\"MOD_SHOTGUN\":4,\"MOD_TRIGGER_HURT\":14},\"items_by_category\":{\"ammo\":35,\"armor\":98,\"health\":17,\"powerup\":13,\"weapon\":226},\"items_by_player\":{\"Assasinu Credi\":{\"ammo\":3,\"armor\":25,\"health\":2,\"powerup\":1,\"weapon\":41},\"Dono da Bola\":{\"ammo\":2,\"armor\":7,\"health\":2,\"powerup\":2,\"weapon\":31},\"Isgalamido\":{\"ammo\":4,\"armor\":17,\"health\":1,\"powerup\":3,\"weapon\":36},\"Mal\":{\"ammo\":13,\"armor\":7,\"health\":3,\"powerup\":1,\"weapon\":42},\"Oootsimo\":{\"ammo\":5,\"armor\":25,\"health\":6,\"powerup\":2,\"weapon\":41},\"Zeh\":{\"ammo\":8,\"armor\":17,\"health\":3,\"powerup\":4,\"weapon\":35}},\"chat\":[],\"connections\":{\"Assasinu Credi\":{\"joined_at\":394,\"left_at\":851,\"time_on_server\":457,\"reconnects\":0,\"present_at_end\":true},\"Dono da Bola\":{\"joined_at\":394,\"left_at\":851,\"time_on_server\":457,\"reconnects\":0,\"present_at_end\":true},\"Isgalamido\":{\"joined_at\":394,\"left_at\":851,\"time_on_server\":457,\"reconnects\":0,\"present_at_end\":true},\"Mal\":{\"joined_at\":394,\"left_at\":851,\"time_on_server\":457,\"reconnects\":0,\"present_at_end\":true},\"Oootsimo\":{\"joined_at\":394,\"left_at\":851,\"time_on_server\":457,\"reconnects\":0,\"present_at_end\":true},\"Zeh\":{\"joined_at\":394,\"left_at\":851,\"time_on_server\":457,\"reconnects\":0,\"present_at_end\":true}},\"kill_matrix\":{\"Assasinu Credi\":{\"Assasinu Credi\":3,\"Dono da Bola\":1,\"Isgalamido\":3,\"Mal\":8,\"Oootsimo\":5,\"Zeh\":2},\"Dono da Bola\":{\"Assasinu Credi\":4,\"Dono da Bola\":2,\"Isgalamido\":3,\"Mal\":4,\"Oootsimo\":3},\"Isgalamido\":{\"Assasinu Credi\":7,\"Dono da Bola\":2,\"Mal\":2,\"Oootsimo\":3,\"Zeh\":5},\"Mal\":{\"Assasinu Credi\":3,\"Dono da Bola\":2,\"Isgalamido\":3,\"Oootsimo\":2,\"Zeh\":2},\"Oootsimo\":{\"Assasinu Credi\":5,\"Dono da Bola\":8,\"Isgalamido\":3,\"Mal\":3,\"Oootsimo\":1,\"Zeh\":4},\"Zeh\":{\"Assasinu Credi\":5,\"Dono da Bola\":2,\"Isgalamido\":5,\"Mal\":7,\"Oootsimo\":2}},\"awards\":{\"first_blood\":\"Dono da Bola\",\"streaks\":{\"Assasinu Credi\":3,\"Dono da Bola\":3,\"Isgalamido\":2,\"Mal\":3,\"Oootsimo\":7,\"Zeh\":5},\"multi_kills\":{\"Assasinu Credi\":{\"double\":1,\"triple\":0,\"quad\":0},\"Isgalamido\":{\"double\":2,\"triple\":0,\"quad\":0},\"Mal\":{\"double\":2,\"triple\":0,\"quad\":0},\"Zeh\":{\"double\":2,\"triple\":0,\"quad\":0}},\"dominations\":{\"Assasinu Credi\":{\"Mal\":5},\"Dono da Bola\":{\"Assasinu Credi\":3},\"Isgalamido\":{\"Assasinu Credi\":3,\"Zeh\":3},\"Oootsimo\":{\"Assasinu Credi\":3,\"Dono da Bola\":5,\"Zeh\":3},\"Zeh\":{\"Assasinu Credi\":3,\"Isgalamido\":3,\"Mal\":5}}}}}",
 			wantErr:    nil,
 		},
 		{
@@ -42,6 +42,23 @@ func TestParser_Parse(t *testing.T) {
 	}
 }
 
+func TestParser_ParseGames(t *testing.T) {
+	p := Parser{}
+	games, err := p.ParseGames("./test/Parse_1.log")
+	assert.NoError(t, err)
+	assert.Len(t, games, 21)
+	assert.Equal(t, 105, games["game_04"].TotalKills)
+
+	games, err = p.ParseGames("./test/Parse_1.log")
+	assert.NoError(t, err)
+	assert.Contains(t, games, "game_01")
+	assert.NotContains(t, games, "game_22")
+
+	games, err = p.ParseGames("./test/Parse_2.log")
+	assert.Nil(t, games)
+	assert.Equal(t, &fs.PathError{Op: "open", Path: "./test/Parse_2.log", Err: syscall.ENOENT}, err)
+}
+
 func TestParser_gameKey(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -152,10 +169,58 @@ func TestParser_initGame(t *testing.T) {
 				gameCounter: 1,
 				log: map[string]Game{
 					"game_01": {
-						TotalKills:   0,
-						Players:      make([]string, 0),
-						Kills:        make(map[string]int),
-						KillsByMeans: make(map[string]int),
+						Map:             "q3dm17",
+						TotalKills:      0,
+						Players:         make([]string, 0),
+						Names:           make(map[string]PlayerName),
+						Kills:           make(map[string]int),
+						KillsByMeans:    make(map[string]int),
+						ItemsByCategory: make(map[string]int),
+						ItemsByPlayer:   make(map[string]map[string]int),
+						Chat:            make([]Message, 0),
+						Connections:     make(map[string]*Connection),
+						KillMatrix:      make(KillMatrix),
+						Awards:          newAwards(),
+					},
+				},
+			},
+		},
+		{
+			name: "Success with team game",
+			fields: Parser{
+				line:        "  0:00 InitGame: \\capturelimit\\8\\g_maxGameClients\\0\\timelimit\\15\\fraglimit\\20\\dmflags\\0\\sv_allowDownload\\0\\sv_maxclients\\16\\sv_privateClients\\2\\g_gametype\\4\\sv_hostname\\Code Miner Server",
+				errorState:  false,
+				gameCounter: 0,
+				log:         make(map[string]Game),
+			},
+			want: Parser{
+				line:        "  0:00 InitGame: \\capturelimit\\8\\g_maxGameClients\\0\\timelimit\\15\\fraglimit\\20\\dmflags\\0\\sv_allowDownload\\0\\sv_maxclients\\16\\sv_privateClients\\2\\g_gametype\\4\\sv_hostname\\Code Miner Server",
+				errorState:  false,
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						TotalKills:      0,
+						Players:         make([]string, 0),
+						Names:           make(map[string]PlayerName),
+						Kills:           make(map[string]int),
+						KillsByMeans:    make(map[string]int),
+						ItemsByCategory: make(map[string]int),
+						ItemsByPlayer:   make(map[string]map[string]int),
+						Chat:            make([]Message, 0),
+						Connections:     make(map[string]*Connection),
+						KillMatrix:      make(KillMatrix),
+						Awards:          newAwards(),
+						Teams: &Teams{
+							Players:   make(map[string]string),
+							Switches:  make(map[string]int),
+							Kills:     make(map[string]int),
+							TeamKills: make(map[string]int),
+							Scores:    make(map[string]int),
+						},
+						CTF: &CTF{
+							Players: make(map[string]*FlagStats),
+							Teams:   make(map[string]*FlagStats),
+						},
 					},
 				},
 			},
@@ -265,6 +330,125 @@ func TestParser_addPlayer(t *testing.T) {
 			},
 			expectedRes: true,
 		},
+		{
+			name: "New player kept with zeros",
+			fields: Parser{
+				KeepAllPlayers: true,
+				line:           " 20:38 ClientUserinfoChanged: 2 n\\Mocinha\\t\\0\\model\\uriel/zael",
+				gameCounter:    1,
+				log: map[string]Game{
+					"game_01": {
+						Players:       []string{"Isgalamido"},
+						Kills:         map[string]int{"Isgalamido": 3},
+						KillsByMeans:  make(map[string]int),
+						ItemsByPlayer: make(map[string]map[string]int),
+						KillMatrix:    make(KillMatrix),
+						Awards:        newAwards(),
+					},
+				},
+			},
+			want: Parser{
+				line:        " 20:38 ClientUserinfoChanged: 2 n\\Mocinha\\t\\0\\model\\uriel/zael",
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						Players:       []string{"Isgalamido", "Mocinha"},
+						Kills:         map[string]int{"Isgalamido": 3, "Mocinha": 0},
+						KillsByMeans:  make(map[string]int),
+						ItemsByPlayer: map[string]map[string]int{"Mocinha": {}},
+						KillMatrix:    KillMatrix{"Mocinha": {}},
+						Awards: &Awards{
+							Streaks:     map[string]int{"Mocinha": 0},
+							MultiKills:  map[string]*MultiKills{"Mocinha": {}},
+							Dominations: map[string]map[string]int{"Mocinha": {}},
+						},
+					},
+				},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Player with color codes",
+			fields: Parser{
+				line:        " 20:38 ClientUserinfoChanged: 2 n\\^1Red^7Name\\t\\0\\model\\uriel/zael",
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						Players: []string{},
+						Names:   make(map[string]PlayerName),
+					},
+				},
+			},
+			want: Parser{
+				line:        " 20:38 ClientUserinfoChanged: 2 n\\^1Red^7Name\\t\\0\\model\\uriel/zael",
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						Players: []string{"RedName"},
+						Names: map[string]PlayerName{
+							"RedName": {DisplayName: "^1Red^7Name", CleanName: "RedName"},
+						},
+					},
+				},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "Player with a backslash",
+			fields: Parser{
+				line:        " 20:38 ClientUserinfoChanged: 2 n\\Back\\slash killed\\t\\0\\model\\uriel/zael",
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						Players: []string{},
+					},
+				},
+			},
+			want: Parser{
+				line:        " 20:38 ClientUserinfoChanged: 2 n\\Back\\slash killed\\t\\0\\model\\uriel/zael",
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						Players: []string{"Back\\slash killed"},
+					},
+				},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "New player to a team",
+			fields: Parser{
+				line:        "  2:33 ClientUserinfoChanged: 4 n\\Zeh\\t\\2\\model\\sarge/default\\hmodel\\sarge/default\\g_redteam\\\\g_blueteam\\\\c1\\1",
+				errorState:  false,
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						Players: []string{},
+						Teams: &Teams{
+							Players:  make(map[string]string),
+							Switches: make(map[string]int),
+						},
+					},
+				},
+			},
+			want: Parser{
+				line:        "  2:33 ClientUserinfoChanged: 4 n\\Zeh\\t\\2\\model\\sarge/default\\hmodel\\sarge/default\\g_redteam\\\\g_blueteam\\\\c1\\1",
+				errorState:  false,
+				gameCounter: 1,
+				log: map[string]Game{
+					"game_01": {
+						Players: []string{"Zeh"},
+						Teams: &Teams{
+							Players: map[string]string{
+								"Zeh": TeamBlue,
+							},
+							Switches: make(map[string]int),
+						},
+					},
+				},
+			},
+			expectedRes: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -344,6 +528,7 @@ func TestParser_addKill(t *testing.T) {
 				log: map[string]Game{
 					"game_01": {
 						TotalKills: 1,
+						Players:    []string{"Isgalamido"},
 						Kills:      make(map[string]int),
 						KillsByMeans: map[string]int{
 							"MOD_ROCKET_SPLASH": 1,
@@ -374,6 +559,7 @@ func TestParser_addKill(t *testing.T) {
 				log: map[string]Game{
 					"game_01": {
 						TotalKills: 1,
+						Players:    []string{"Isgalamido", "Dono da Bola"},
 						Kills: map[string]int{
 							"Isgalamido": 1,
 						},
@@ -406,6 +592,7 @@ func TestParser_addKill(t *testing.T) {
 				log: map[string]Game{
 					"game_01": {
 						TotalKills: 1,
+						Players:    []string{"Isgalamido"},
 						Kills: map[string]int{
 							"Isgalamido": -1,
 						},
@@ -417,102 +604,80 @@ func TestParser_addKill(t *testing.T) {
 			},
 			expextedRes: true,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			res := tt.fields.addKill()
-			assert.Equal(t, tt.expextedRes, res)
-			assert.Equal(t, tt.want.line, tt.fields.line)
-			assert.Equal(t, tt.want.errorState, tt.fields.errorState)
-			assert.Equal(t, tt.want.gameCounter, tt.fields.gameCounter)
-			assert.Equal(t, tt.want.log, tt.fields.log)
-		})
-	}
-}
-
-func TestParser_addWeaponKill(t *testing.T) {
-	tests := []struct {
-		name   string
-		weapon string
-		fields Parser
-		want   Parser
-	}{
 		{
-			name:   "Success",
-			weapon: "MOD_ROCKET",
+			name: "Success with names resolved by IDs",
 			fields: Parser{
+				line:        "  3:13 Kill: 3 2 6: Dono killed by Bola killed Isgalamido by MOD_ROCKET",
+				errorState:  false,
 				gameCounter: 1,
+				clients:     map[string]string{"2": "Isgalamido", "3": "Dono killed by Bola"},
 				log: map[string]Game{
 					"game_01": {
 						TotalKills:   0,
+						Kills:        make(map[string]int),
 						KillsByMeans: make(map[string]int),
 					},
 				},
 			},
 			want: Parser{
+				line:        "  3:13 Kill: 3 2 6: Dono killed by Bola killed Isgalamido by MOD_ROCKET",
+				errorState:  false,
 				gameCounter: 1,
 				log: map[string]Game{
 					"game_01": {
+						TotalKills: 1,
+						Kills: map[string]int{
+							"Dono killed by Bola": 1,
+						},
 						KillsByMeans: map[string]int{
 							"MOD_ROCKET": 1,
 						},
 					},
 				},
 			},
+			expextedRes: true,
 		},
 		{
-			name:   "Success with same weapon",
-			weapon: "MOD_ROCKET",
+			name: "Success with names inconsistent with IDs",
 			fields: Parser{
+				line:        "  3:13 Kill: 3 2 10: Mal killed Isgalamido by MOD_ROCKET",
+				errorState:  false,
 				gameCounter: 1,
+				clients:     map[string]string{"2": "Isgalamido", "3": "Zeh"},
 				log: map[string]Game{
 					"game_01": {
-						KillsByMeans: map[string]int{
-							"MOD_ROCKET": 1,
-						},
+						TotalKills:   0,
+						Kills:        make(map[string]int),
+						KillsByMeans: make(map[string]int),
 					},
 				},
 			},
 			want: Parser{
+				line:        "  3:13 Kill: 3 2 10: Mal killed Isgalamido by MOD_ROCKET",
+				errorState:  false,
 				gameCounter: 1,
 				log: map[string]Game{
 					"game_01": {
-						KillsByMeans: map[string]int{
-							"MOD_ROCKET": 2,
+						TotalKills: 1,
+						Kills: map[string]int{
+							"Zeh": 1,
 						},
-					},
-				},
-			},
-		},
-		{
-			name:   "Success with different weapon",
-			weapon: "MOD_ROCKET_SPLASH",
-			fields: Parser{
-				gameCounter: 1,
-				log: map[string]Game{
-					"game_01": {
 						KillsByMeans: map[string]int{
-							"MOD_ROCKET": 1,
+							"MOD_RAILGUN": 1,
 						},
-					},
-				},
-			},
-			want: Parser{
-				gameCounter: 1,
-				log: map[string]Game{
-					"game_01": {
-						KillsByMeans: map[string]int{
-							"MOD_ROCKET":        1,
-							"MOD_ROCKET_SPLASH": 1,
+						Inconsistencies: []Inconsistency{
+							{Time: 193, Logged: "Mal killed Isgalamido by MOD_ROCKET", Resolved: "Zeh killed Isgalamido by MOD_RAILGUN"},
 						},
 					},
 				},
 			},
+			expextedRes: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.fields.addWeaponKill(tt.weapon)
+			res := tt.fields.addKill()
+			assert.Equal(t, tt.expextedRes, res)
 			assert.Equal(t, tt.want.line, tt.fields.line)
 			assert.Equal(t, tt.want.errorState, tt.fields.errorState)
 			assert.Equal(t, tt.want.gameCounter, tt.fields.gameCounter)
@@ -521,44 +686,128 @@ func TestParser_addWeaponKill(t *testing.T) {
 	}
 }
 
-func TestParser_addPlayerKill(t *testing.T) {
+func TestParser_gameType(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields Parser
+		want   int
+	}{
+		{
+			name:   "Free for all",
+			fields: Parser{line: "  0:00 InitGame: \\sv_hostname\\Code Miner Server\\g_gametype\\0\\sv_privateClients\\2"},
+			want:   0,
+		},
+		{
+			name:   "Capture the flag",
+			fields: Parser{line: "  0:00 InitGame: \\sv_hostname\\Code Miner Server\\g_gametype\\4\\sv_privateClients\\2"},
+			want:   4,
+		},
+		{
+			name:   "Malformed value",
+			fields: Parser{line: "  0:00 InitGame: \\sv_hostname\\Code Miner Server\\g_gametype\\= 3\\sv_privateClients\\2"},
+			want:   3,
+		},
+		{
+			name:   "Missing value",
+			fields: Parser{line: "  0:00 InitGame: \\sv_hostname\\Code Miner Server"},
+			want:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameType := tt.fields.gameType()
+			assert.Equal(t, tt.want, gameType)
+		})
+	}
+}
+
+func TestParser_serverInfo(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields Parser
+		key    string
+		want   string
+	}{
+		{
+			name:   "Map",
+			fields: Parser{line: "  0:00 InitGame: \\sv_hostname\\Code Miner Server\\mapname\\q3dm17\\gamename\\baseq3"},
+			key:    "mapname",
+			want:   "q3dm17",
+		},
+		{
+			name:   "Value with spaces",
+			fields: Parser{line: "  0:00 InitGame: \\sv_hostname\\Code Miner Server\\mapname\\q3dm17"},
+			key:    "sv_hostname",
+			want:   "Code Miner Server",
+		},
+		{
+			name:   "Last key",
+			fields: Parser{line: "  0:00 InitGame: \\sv_hostname\\Code Miner Server\\gamename\\baseq3"},
+			key:    "gamename",
+			want:   "baseq3",
+		},
+		{
+			name:   "Missing key",
+			fields: Parser{line: "  0:00 InitGame: \\sv_hostname\\Code Miner Server"},
+			key:    "mapname",
+			want:   "",
+		},
+		{
+			name:   "Repeated key",
+			fields: Parser{line: "  0:00 InitGame: \\mapname\\q3dm17\\gamename\\baseq3\\mapname\\q3dm6"},
+			key:    "mapname",
+			want:   "q3dm17",
+		},
+		{
+			name:   "Empty value",
+			fields: Parser{line: "  0:00 InitGame: \\g_needpass\\\\mapname\\q3dm17"},
+			key:    "mapname",
+			want:   "q3dm17",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.fields.serverInfo()[tt.key])
+		})
+	}
+}
+
+func TestParser_setTeam(t *testing.T) {
 	tests := []struct {
 		name   string
-		killer string
+		player string
+		teamID string
 		fields Parser
 		want   Parser
 	}{
 		{
-			name:   "Success",
-			killer: "Isgalamido",
+			name:   "Not a team game",
+			player: "Zeh",
+			teamID: "1",
 			fields: Parser{
 				gameCounter: 1,
 				log: map[string]Game{
-					"game_01": {
-						Kills: make(map[string]int),
-					},
+					"game_01": {},
 				},
 			},
 			want: Parser{
 				gameCounter: 1,
 				log: map[string]Game{
-					"game_01": {
-						Kills: map[string]int{
-							"Isgalamido": 1,
-						},
-					},
+					"game_01": {},
 				},
 			},
 		},
 		{
-			name:   "Success with a second hit from the same player",
-			killer: "Isgalamido",
+			name:   "Unknown team",
+			player: "Zeh",
+			teamID: "",
 			fields: Parser{
 				gameCounter: 1,
 				log: map[string]Game{
 					"game_01": {
-						Kills: map[string]int{
-							"Isgalamido": 1,
+						Teams: &Teams{
+							Players:  make(map[string]string),
+							Switches: make(map[string]int),
 						},
 					},
 				},
@@ -567,22 +816,27 @@ func TestParser_addPlayerKill(t *testing.T) {
 				gameCounter: 1,
 				log: map[string]Game{
 					"game_01": {
-						Kills: map[string]int{
-							"Isgalamido": 2,
+						Teams: &Teams{
+							Players:  make(map[string]string),
+							Switches: make(map[string]int),
 						},
 					},
 				},
 			},
 		},
 		{
-			name:   "Success with a hit from a different player",
-			killer: "Zeh",
+			name:   "Same team",
+			player: "Zeh",
+			teamID: "1",
 			fields: Parser{
 				gameCounter: 1,
 				log: map[string]Game{
 					"game_01": {
-						Kills: map[string]int{
-							"Isgalamido": 1,
+						Teams: &Teams{
+							Players: map[string]string{
+								"Zeh": TeamRed,
+							},
+							Switches: make(map[string]int),
 						},
 					},
 				},
@@ -591,24 +845,29 @@ func TestParser_addPlayerKill(t *testing.T) {
 				gameCounter: 1,
 				log: map[string]Game{
 					"game_01": {
-						Kills: map[string]int{
-							"Isgalamido": 1,
-							"Zeh":        1,
+						Teams: &Teams{
+							Players: map[string]string{
+								"Zeh": TeamRed,
+							},
+							Switches: make(map[string]int),
 						},
 					},
 				},
 			},
 		},
 		{
-			name:   "Success with a hit from a player with -1 kills",
-			killer: "Zeh",
+			name:   "Team switch",
+			player: "Zeh",
+			teamID: "2",
 			fields: Parser{
 				gameCounter: 1,
 				log: map[string]Game{
 					"game_01": {
-						Kills: map[string]int{
-							"Isgalamido": 1,
-							"Zeh":        -1,
+						Teams: &Teams{
+							Players: map[string]string{
+								"Zeh": TeamRed,
+							},
+							Switches: make(map[string]int),
 						},
 					},
 				},
@@ -617,8 +876,13 @@ func TestParser_addPlayerKill(t *testing.T) {
 				gameCounter: 1,
 				log: map[string]Game{
 					"game_01": {
-						Kills: map[string]int{
-							"Isgalamido": 1,
+						Teams: &Teams{
+							Players: map[string]string{
+								"Zeh": TeamBlue,
+							},
+							Switches: map[string]int{
+								"Zeh": 1,
+							},
 						},
 					},
 				},
@@ -627,119 +891,172 @@ func TestParser_addPlayerKill(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.fields.addPlayerKill(tt.killer)
-			assert.Equal(t, tt.want.line, tt.fields.line)
-			assert.Equal(t, tt.want.errorState, tt.fields.errorState)
+			tt.fields.setTeam(tt.player, tt.teamID)
 			assert.Equal(t, tt.want.gameCounter, tt.fields.gameCounter)
 			assert.Equal(t, tt.want.log, tt.fields.log)
 		})
 	}
 }
 
-func TestParser_addWorldKill(t *testing.T) {
+func TestParser_addTeamKill(t *testing.T) {
+	newTeams := func() *Teams {
+		return &Teams{
+			Players: map[string]string{
+				"Isgalamido":   TeamRed,
+				"Dono da Bola": TeamRed,
+				"Zeh":          TeamBlue,
+				"Mal":          TeamSpectator,
+			},
+			Kills:     make(map[string]int),
+			TeamKills: make(map[string]int),
+		}
+	}
+
 	tests := []struct {
-		name   string
-		victim string
-		fields Parser
-		want   Parser
+		name          string
+		killer        string
+		victim        string
+		fields        Parser
+		wantKills     map[string]int
+		wantTeamKills map[string]int
 	}{
 		{
-			name:   "Success",
-			victim: "Isgalamido",
+			name:          "Enemy kill",
+			killer:        "Isgalamido",
+			victim:        "Zeh",
+			fields:        Parser{gameCounter: 1, log: map[string]Game{"game_01": {Teams: newTeams()}}},
+			wantKills:     map[string]int{TeamRed: 1},
+			wantTeamKills: map[string]int{},
+		},
+		{
+			name:          "Team kill",
+			killer:        "Isgalamido",
+			victim:        "Dono da Bola",
+			fields:        Parser{gameCounter: 1, log: map[string]Game{"game_01": {Teams: newTeams()}}},
+			wantKills:     map[string]int{},
+			wantTeamKills: map[string]int{TeamRed: 1},
+		},
+		{
+			name:          "Suicide",
+			killer:        "Zeh",
+			victim:        "Zeh",
+			fields:        Parser{gameCounter: 1, log: map[string]Game{"game_01": {Teams: newTeams()}}},
+			wantKills:     map[string]int{},
+			wantTeamKills: map[string]int{},
+		},
+		{
+			name:          "World kill",
+			killer:        "<world>",
+			victim:        "Zeh",
+			fields:        Parser{gameCounter: 1, log: map[string]Game{"game_01": {Teams: newTeams()}}},
+			wantKills:     map[string]int{},
+			wantTeamKills: map[string]int{},
+		},
+		{
+			name:          "Killer without team",
+			killer:        "Mal",
+			victim:        "Zeh",
+			fields:        Parser{gameCounter: 1, log: map[string]Game{"game_01": {Teams: newTeams()}}},
+			wantKills:     map[string]int{},
+			wantTeamKills: map[string]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fields.addTeamKill(tt.killer, tt.victim)
+			assert.Equal(t, tt.wantKills, tt.fields.log["game_01"].Teams.Kills)
+			assert.Equal(t, tt.wantTeamKills, tt.fields.log["game_01"].Teams.TeamKills)
+		})
+	}
+}
+
+func TestParser_addTeamScore(t *testing.T) {
+	tests := []struct {
+		name        string
+		fields      Parser
+		want        Parser
+		expectedRes bool
+	}{
+		{
+			name:        "Not team score line",
+			fields:      Parser{},
+			want:        Parser{},
+			expectedRes: false,
+		},
+		{
+			name: "Malformed scores are ignored",
 			fields: Parser{
+				line:        " 10:12 red:8",
 				gameCounter: 1,
-				log: map[string]Game{
-					"game_01": {
-						Kills: make(map[string]int),
-					},
-				},
+				log:         map[string]Game{"game_01": {}},
 			},
 			want: Parser{
+				line:        " 10:12 red:8",
 				gameCounter: 1,
-				log: map[string]Game{
-					"game_01": {
-						Kills: map[string]int{
-							"Isgalamido": -1,
-						},
-					},
-				},
+				log:         map[string]Game{"game_01": {}},
 			},
+			expectedRes: true,
 		},
 		{
-			name:   "Success with a second hit to the same player",
-			victim: "Isgalamido",
+			name: "Score line of a player named red:",
 			fields: Parser{
+				line:        " 10:12 score: 20  ping: 4  client: 0 Fred:",
 				gameCounter: 1,
-				log: map[string]Game{
-					"game_01": {
-						Kills: map[string]int{
-							"Isgalamido": -1,
-						},
-					},
-				},
+				log:         map[string]Game{"game_01": {}},
 			},
 			want: Parser{
+				line:        " 10:12 score: 20  ping: 4  client: 0 Fred:",
 				gameCounter: 1,
-				log: map[string]Game{
-					"game_01": {
-						Kills: map[string]int{
-							"Isgalamido": -2,
-						},
-					},
-				},
+				log:         map[string]Game{"game_01": {}},
 			},
+			expectedRes: false,
 		},
 		{
-			name:   "Success with a hit to a different player",
-			victim: "Zeh",
+			name: "Not a team game",
 			fields: Parser{
+				line:        " 10:12 red:8  blue:6",
 				gameCounter: 1,
-				log: map[string]Game{
-					"game_01": {
-						Kills: map[string]int{
-							"Isgalamido": 1,
-						},
-					},
-				},
+				log:         map[string]Game{"game_01": {}},
 			},
 			want: Parser{
+				line:        " 10:12 red:8  blue:6",
 				gameCounter: 1,
-				log: map[string]Game{
-					"game_01": {
-						Kills: map[string]int{
-							"Isgalamido": 1,
-							"Zeh":        -1,
-						},
-					},
-				},
+				log:         map[string]Game{"game_01": {}},
 			},
+			expectedRes: true,
 		},
 		{
-			name:   "Success with a hit to a player with 1 kill",
-			victim: "Isgalamido",
+			name: "Success",
 			fields: Parser{
+				line:        " 10:12 red:8  blue:6",
 				gameCounter: 1,
 				log: map[string]Game{
 					"game_01": {
-						Kills: map[string]int{
-							"Isgalamido": 1,
-						},
+						Teams: &Teams{Scores: make(map[string]int)},
 					},
 				},
 			},
 			want: Parser{
+				line:        " 10:12 red:8  blue:6",
 				gameCounter: 1,
 				log: map[string]Game{
 					"game_01": {
-						Kills: make(map[string]int),
+						Teams: &Teams{
+							Scores: map[string]int{
+								TeamRed:  8,
+								TeamBlue: 6,
+							},
+						},
 					},
 				},
 			},
+			expectedRes: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.fields.addWorldKill(tt.victim)
+			res := tt.fields.addTeamScore()
+			assert.Equal(t, tt.expectedRes, res)
 			assert.Equal(t, tt.want.line, tt.fields.line)
 			assert.Equal(t, tt.want.errorState, tt.fields.errorState)
 			assert.Equal(t, tt.want.gameCounter, tt.fields.gameCounter)
@@ -748,83 +1065,142 @@ func TestParser_addWorldKill(t *testing.T) {
 	}
 }
 
-func TestParser_handleZeroKills(t *testing.T) {
+func TestParser_setClient(t *testing.T) {
 	tests := []struct {
 		name   string
-		victim string
+		id     string
+		player string
 		fields Parser
-		want   Parser
+		want   map[string]string
 	}{
 		{
-			name:   "No zero kills",
-			victim: "Assasinu Credi",
-			fields: Parser{
-				line:        "  8:30 Kill: 1022 5 22: <world> killed Assasinu Credi by MOD_TRIGGER_HURT",
-				gameCounter: 1,
-				log: map[string]Game{
-					"game_01": {
-						Kills: map[string]int{
-							"Assasinu Credi": 2,
-						},
-						KillsByMeans: map[string]int{
-							"MOD_ROCKET_SPLASH": 2,
-						},
-					},
-				},
-			},
-			want: Parser{
-				line:        "  8:30 Kill: 1022 5 22: <world> killed Assasinu Credi by MOD_TRIGGER_HURT",
-				gameCounter: 1,
-				log: map[string]Game{
-					"game_01": {
-						Kills: map[string]int{
-							"Assasinu Credi": 2,
-						},
-						KillsByMeans: map[string]int{
-							"MOD_ROCKET_SPLASH": 2,
-						},
-					},
-				},
-			},
+			name:   "New client",
+			id:     "2",
+			player: "Isgalamido",
+			fields: Parser{},
+			want:   map[string]string{"2": "Isgalamido"},
 		},
 		{
-			name:   "Zero kills",
-			victim: "Assasinu Credi",
-			fields: Parser{
-				line:        "  8:30 Kill: 1022 5 22: <world> killed Assasinu Credi by MOD_TRIGGER_HURT",
-				gameCounter: 1,
-				log: map[string]Game{
-					"game_01": {
-						Kills: map[string]int{
-							"Assasinu Credi": 0,
-						},
-						KillsByMeans: map[string]int{
-							"MOD_ROCKET_SPLASH": 2,
-						},
-					},
-				},
-			},
-			want: Parser{
-				line:        "  8:30 Kill: 1022 5 22: <world> killed Assasinu Credi by MOD_TRIGGER_HURT",
-				gameCounter: 1,
-				log: map[string]Game{
-					"game_01": {
-						Kills: make(map[string]int),
-						KillsByMeans: map[string]int{
-							"MOD_ROCKET_SPLASH": 2,
-						},
-					},
-				},
-			},
+			name:   "Renamed client",
+			id:     "3",
+			player: "Mocinha",
+			fields: Parser{clients: map[string]string{"2": "Isgalamido", "3": "Dono da Bola"}},
+			want:   map[string]string{"2": "Isgalamido", "3": "Mocinha"},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.fields.handleZeroKills(tt.victim)
-			assert.Equal(t, tt.want.line, tt.fields.line)
-			assert.Equal(t, tt.want.errorState, tt.fields.errorState)
-			assert.Equal(t, tt.want.gameCounter, tt.fields.gameCounter)
-			assert.Equal(t, tt.want.log, tt.fields.log)
+			tt.fields.setClient(tt.id, tt.player)
+			assert.Equal(t, tt.want, tt.fields.clients)
+		})
+	}
+}
+
+func TestParser_timestamp(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields Parser
+		want   int
+	}{
+		{
+			name:   "Minutes and seconds",
+			fields: Parser{line: " 12:35 Item: 4 team_CTF_blueflag"},
+			want:   755,
+		},
+		{
+			name:   "Three digit minutes",
+			fields: Parser{line: "981:21 say: Oootsimo: team red"},
+			want:   58881,
+		},
+		{
+			name:   "No timestamp",
+			fields: Parser{line: "Item: 4 team_CTF_blueflag"},
+			want:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timestamp := tt.fields.timestamp()
+			assert.Equal(t, tt.want, timestamp)
+		})
+	}
+}
+
+func TestParser_ParseLine_beforeInitGame(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{name: "Item", line: "  0:01 Item: 0 item_armor_shard"},
+		{name: "Say", line: "  0:01 say: Zeh: hi"},
+		{name: "Userinfo", line: `  0:01 ClientUserinfoChanged: 2 n\Isgalamido\t\0\model\sarge`},
+		{name: "Kill", line: "  0:01 Kill: 1022 2 22: <world> killed Isgalamido by MOD_TRIGGER_HURT"},
+		{name: "Team score", line: "  0:01 red:8  blue:6"},
+		{name: "Disconnect", line: "  0:01 ClientDisconnect: 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Parser{}
+			p.ParseLine(tt.line)
+			p.ParseLine(`  0:02 InitGame: \sv_hostname\Code Miner Server\g_gametype\0\mapname\q3dm17\gamename\baseq3`)
+			p.End()
+
+			games := p.Games()
+			assert.Len(t, games, 1)
+			assert.Contains(t, games, "game_01")
+			assert.Equal(t, 0, games["game_01"].TotalKills)
+			assert.Empty(t, games["game_01"].Players)
+			assert.Empty(t, games["game_01"].Chat)
+		})
+	}
+}
+
+func TestParser_ParseLine_allPlayers(t *testing.T) {
+	lines := []string{
+		`  0:00 InitGame: \sv_hostname\Code Miner Server\g_gametype\4\mapname\q3ctf1\gamename\baseq3`,
+		`  0:01 ClientUserinfoChanged: 2 n\Isgalamido\t\1\model\sarge`,
+		`  0:01 ClientUserinfoChanged: 3 n\Zeh\t\2\model\sarge`,
+		`  0:01 ClientUserinfoChanged: 4 n\Mal\t\2\model\sarge`,
+		`  0:02 Kill: 2 3 10: Isgalamido killed Zeh by MOD_RAILGUN`,
+		`  0:03 Kill: 2 4 10: Isgalamido killed Mal by MOD_RAILGUN`,
+		`  0:04 ClientUserinfoChanged: 4 n\Mal\t\1\model\sarge`,
+		`  0:05 Item: 2 team_CTF_blueflag`,
+	}
+
+	tests := []struct {
+		name          string
+		ctfEvents     bool
+		wantCTFPlayer map[string]*FlagStats
+	}{
+		{
+			name:          "Flag touches",
+			wantCTFPlayer: map[string]*FlagStats{"Isgalamido": {Pickups: 1}, "Zeh": {}, "Mal": {}},
+		},
+		{
+			name:          "CTF events",
+			ctfEvents:     true,
+			wantCTFPlayer: map[string]*FlagStats{"Isgalamido": {Captures: 1}, "Zeh": {}, "Mal": {}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Parser{KeepAllPlayers: true}
+			for _, line := range lines {
+				p.ParseLine(line)
+			}
+			if tt.ctfEvents {
+				p.ParseLine(`  0:06 CTF: 2 2 1: Isgalamido captured the BLUE flag!`)
+			}
+			p.End()
+
+			game := p.log["game_01"]
+			assert.Equal(t, map[string]*MultiKills{"Isgalamido": {Double: 1}, "Zeh": {}, "Mal": {}}, game.Awards.MultiKills)
+			assert.Equal(t, map[string]map[string]int{"Isgalamido": {}, "Zeh": {}, "Mal": {}}, game.Awards.Dominations)
+			assert.Equal(t, map[string]int{"Isgalamido": 0, "Zeh": 0, "Mal": 1}, game.Teams.Switches)
+			assert.Equal(t, map[string]int{TeamRed: 2, TeamBlue: 0}, game.Teams.Kills)
+			assert.Equal(t, map[string]int{TeamRed: 0, TeamBlue: 0}, game.Teams.TeamKills)
+			assert.Equal(t, tt.wantCTFPlayer, game.CTF.Players)
+			assert.Len(t, game.CTF.Teams, 2)
 		})
 	}
 }
@@ -0,0 +1,62 @@
+package parser
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PlayerStats aggregates the games of a player.
+type PlayerStats struct {
+	Player string   `json:"player"`
+	Games  []string `json:"games"`
+	Kills  int      `json:"kills"`
+}
+
+// GameKeys returns the keys of the games in the order they were played.
+func GameKeys(games map[string]Game) []string {
+	keys := make([]string, 0, len(games))
+	for key := range games {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return gameNumber(keys[i]) < gameNumber(keys[j])
+	})
+	return keys
+}
+
+func gameNumber(key string) int {
+	number, _ := strconv.Atoi(strings.TrimPrefix(key, "game_"))
+	return number
+}
+
+// Players aggregates every player of the games, by name.
+func Players(games map[string]Game) map[string]*PlayerStats {
+	players := make(map[string]*PlayerStats)
+	for _, key := range GameKeys(games) {
+		game := games[key]
+		for _, player := range game.Players {
+			if _, ok := players[player]; !ok {
+				players[player] = &PlayerStats{Player: player, Games: make([]string, 0)}
+			}
+			players[player].Games = append(players[player].Games, key)
+			players[player].Kills += game.Kills[player]
+		}
+	}
+	return players
+}
+
+// Ranking returns the players of the games ordered by kills, then by name.
+func Ranking(games map[string]Game) []PlayerStats {
+	ranking := make([]PlayerStats, 0)
+	for _, player := range Players(games) {
+		ranking = append(ranking, *player)
+	}
+	sort.Slice(ranking, func(i, j int) bool {
+		if ranking[i].Kills != ranking[j].Kills {
+			return ranking[i].Kills > ranking[j].Kills
+		}
+		return ranking[i].Player < ranking[j].Player
+	})
+	return ranking
+}
@@ -0,0 +1,72 @@
+//go:build unit
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGameKeys(t *testing.T) {
+	games := map[string]Game{
+		"game_100": {},
+		"game_02":  {},
+		"game_10":  {},
+		"game_01":  {},
+	}
+	assert.Equal(t, []string{"game_01", "game_02", "game_10", "game_100"}, GameKeys(games))
+}
+
+func TestPlayers(t *testing.T) {
+	games := map[string]Game{
+		"game_01": {
+			Players: []string{"Isgalamido", "Zeh"},
+			Kills:   map[string]int{"Isgalamido": 3, "Zeh": -1},
+		},
+		"game_02": {
+			Players: []string{"Zeh", "Mal"},
+			Kills:   map[string]int{"Zeh": 5},
+		},
+	}
+
+	want := map[string]*PlayerStats{
+		"Isgalamido": {Player: "Isgalamido", Games: []string{"game_01"}, Kills: 3},
+		"Zeh":        {Player: "Zeh", Games: []string{"game_01", "game_02"}, Kills: 4},
+		"Mal":        {Player: "Mal", Games: []string{"game_02"}, Kills: 0},
+	}
+	assert.Equal(t, want, Players(games))
+}
+
+func TestRanking(t *testing.T) {
+	tests := []struct {
+		name  string
+		games map[string]Game
+		want  []PlayerStats
+	}{
+		{
+			name:  "No games",
+			games: map[string]Game{},
+			want:  []PlayerStats{},
+		},
+		{
+			name: "Ordered by kills then name",
+			games: map[string]Game{
+				"game_01": {
+					Players: []string{"Zeh", "Mal", "Isgalamido"},
+					Kills:   map[string]int{"Isgalamido": 2, "Zeh": 2, "Mal": 7},
+				},
+			},
+			want: []PlayerStats{
+				{Player: "Mal", Games: []string{"game_01"}, Kills: 7},
+				{Player: "Isgalamido", Games: []string{"game_01"}, Kills: 2},
+				{Player: "Zeh", Games: []string{"game_01"}, Kills: 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Ranking(tt.games))
+		})
+	}
+}
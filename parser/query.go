@@ -0,0 +1,107 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Query selects games; its zero value matches every game.
+	Query struct {
+		// Player only matches the games the player played.
+		Player string
+		// Map only matches the games played on the map.
+		Map string
+		// Weapon only matches the games with kills by this means of death, or by this weapon family.
+		Weapon string
+		// MinKills only matches the games with at least this many kills,
+		// counting the kills of Player when set.
+		MinKills int
+		// Games only matches the games in the range.
+		Games GameRange
+	}
+
+	// GameRange is a range of game numbers, both ends included; a zero end leaves the range open.
+	GameRange struct {
+		From int
+		To   int
+	}
+)
+
+// ParseGameRange parses a range of game numbers such as "4..10", "4..", "..10" or "4".
+func ParseGameRange(s string) (GameRange, error) {
+	if s == "" {
+		return GameRange{}, nil
+	}
+
+	from, to, found := strings.Cut(s, "..")
+	if !found {
+		to = from
+	}
+
+	var r GameRange
+	var err error
+	if from != "" {
+		if r.From, err = strconv.Atoi(from); err != nil || r.From < 1 {
+			return GameRange{}, fmt.Errorf("invalid game range %q", s)
+		}
+	}
+	if to != "" {
+		if r.To, err = strconv.Atoi(to); err != nil || r.To < 1 {
+			return GameRange{}, fmt.Errorf("invalid game range %q", s)
+		}
+	}
+	if r.To != 0 && r.From > r.To {
+		return GameRange{}, fmt.Errorf("invalid game range %q", s)
+	}
+	return r, nil
+}
+
+// Contains reports whether the game, by its key, is in the range.
+func (r GameRange) Contains(key string) bool {
+	number := gameNumber(key)
+	return (r.From == 0 || number >= r.From) && (r.To == 0 || number <= r.To)
+}
+
+// Match reports whether the game, by its key, matches every criterion of the query.
+func (q Query) Match(key string, game Game) bool {
+	if !q.Games.Contains(key) {
+		return false
+	}
+	if q.Map != "" && game.Map != q.Map {
+		return false
+	}
+	if q.Weapon != "" && game.KillsByMeans[q.Weapon] == 0 && KillsByFamily(game.KillsByMeans)[q.Weapon] == 0 {
+		return false
+	}
+	if q.Player != "" && !playedBy(game, q.Player) {
+		return false
+	}
+
+	kills := game.TotalKills
+	if q.Player != "" {
+		kills = game.Kills[q.Player]
+	}
+	return kills >= q.MinKills
+}
+
+// Filter returns the games matching the query.
+func Filter(games map[string]Game, q Query) map[string]Game {
+	matches := make(map[string]Game)
+	for key, game := range games {
+		if q.Match(key, game) {
+			matches[key] = game
+		}
+	}
+	return matches
+}
+
+func playedBy(game Game, player string) bool {
+	for _, p := range game.Players {
+		if p == player {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+//go:build unit
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGameRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    GameRange
+		wantErr bool
+	}{
+		{name: "Empty", s: "", want: GameRange{}},
+		{name: "Range", s: "4..10", want: GameRange{From: 4, To: 10}},
+		{name: "Single game", s: "4", want: GameRange{From: 4, To: 4}},
+		{name: "Open end", s: "4..", want: GameRange{From: 4}},
+		{name: "Open start", s: "..10", want: GameRange{To: 10}},
+		{name: "Reversed", s: "10..4", wantErr: true},
+		{name: "Not a number", s: "four..10", wantErr: true},
+		{name: "Zero", s: "0..10", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseGameRange(tt.s)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGameRange_Contains(t *testing.T) {
+	tests := []struct {
+		name string
+		r    GameRange
+		key  string
+		want bool
+	}{
+		{name: "Unbounded", r: GameRange{}, key: "game_21", want: true},
+		{name: "Inside", r: GameRange{From: 4, To: 10}, key: "game_04", want: true},
+		{name: "Before", r: GameRange{From: 4, To: 10}, key: "game_03", want: false},
+		{name: "After", r: GameRange{From: 4, To: 10}, key: "game_11", want: false},
+		{name: "Open end", r: GameRange{From: 4}, key: "game_99", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.r.Contains(tt.key))
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	games := map[string]Game{
+		"game_01": {
+			Map:          "q3dm17",
+			TotalKills:   60,
+			Players:      []string{"Zeh", "Isgalamido"},
+			Kills:        map[string]int{"Zeh": 10, "Isgalamido": 45},
+			KillsByMeans: map[string]int{"MOD_RAILGUN": 20, "MOD_ROCKET": 40},
+		},
+		"game_02": {
+			Map:          "q3dm6",
+			TotalKills:   5,
+			Players:      []string{"Zeh"},
+			Kills:        map[string]int{"Zeh": 3},
+			KillsByMeans: map[string]int{"MOD_ROCKET": 5},
+		},
+		"game_03": {
+			Map:          "q3dm17",
+			TotalKills:   0,
+			Players:      []string{"Mal"},
+			Kills:        map[string]int{},
+			KillsByMeans: map[string]int{},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		query Query
+		want  []string
+	}{
+		{name: "Everything", query: Query{}, want: []string{"game_01", "game_02", "game_03"}},
+		{name: "Player", query: Query{Player: "Zeh"}, want: []string{"game_01", "game_02"}},
+		{name: "Map", query: Query{Map: "q3dm17"}, want: []string{"game_01", "game_03"}},
+		{name: "Weapon", query: Query{Weapon: "MOD_RAILGUN"}, want: []string{"game_01"}},
+		{name: "Weapon family", query: Query{Weapon: FamilyRocket}, want: []string{"game_01", "game_02"}},
+		{name: "Min kills", query: Query{MinKills: 50}, want: []string{"game_01"}},
+		{name: "Min kills of player", query: Query{Player: "Zeh", MinKills: 5}, want: []string{"game_01"}},
+		{name: "Games", query: Query{Games: GameRange{From: 2, To: 3}}, want: []string{"game_02", "game_03"}},
+		{name: "Combined", query: Query{Player: "Zeh", Weapon: "MOD_ROCKET", Games: GameRange{From: 2}}, want: []string{"game_02"}},
+		{name: "No match", query: Query{Player: "Dono da Bola"}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, GameKeys(Filter(games, tt.query)))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package parser
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"sort"
+)
+
+type (
+	// PlayerRating is the Elo rating of a player after a sequence of games.
+	PlayerRating struct {
+		Player  string         `json:"player"`
+		Rating  float64        `json:"rating"`
+		Games   int            `json:"games"`
+		History []RatingChange `json:"history"`
+	}
+
+	// RatingChange is the rating of a player after a game.
+	RatingChange struct {
+		Game   string  `json:"game"`
+		Rating float64 `json:"rating"`
+		Change float64 `json:"change"`
+	}
+)
+
+const (
+	// InitialRating is the rating of a player never rated before.
+	InitialRating = 1500.0
+	// eloK is the most rating a player wins or loses against each opponent of a game.
+	eloK = 16.0
+)
+
+// Ratings rates the players over the games, in the order they were played, starting from the
+// seed ratings, e.g. read by ReadRatings, or from InitialRating. Every pair of players who killed
+// each other in a game plays a match scored by their share of the kills between them, the rating
+// changes of a game being applied once it is over. Seeded players who did not play keep their rating.
+// The players are ordered by rating, then by name.
+func Ratings(games map[string]Game, seed map[string]float64) []PlayerRating {
+	ratings := make(map[string]*PlayerRating)
+	rating := func(player string) *PlayerRating {
+		if _, ok := ratings[player]; !ok {
+			initial, ok := seed[player]
+			if !ok {
+				initial = InitialRating
+			}
+			ratings[player] = &PlayerRating{Player: player, Rating: initial, History: make([]RatingChange, 0)}
+		}
+		return ratings[player]
+	}
+	for player := range seed {
+		rating(player)
+	}
+
+	for _, key := range GameKeys(games) {
+		game := games[key]
+		players := append([]string(nil), game.Players...)
+		sort.Strings(players)
+
+		changes := make(map[string]float64)
+		for i, a := range players {
+			for _, b := range players[i+1:] {
+				won, lost := game.KillMatrix[a][b], game.KillMatrix[b][a]
+				if won+lost == 0 {
+					continue
+				}
+
+				score := float64(won) / float64(won+lost)
+				expected := 1 / (1 + math.Pow(10, (rating(b).Rating-rating(a).Rating)/400))
+				change := eloK * (score - expected)
+				changes[a] += change
+				changes[b] -= change
+			}
+		}
+
+		for _, player := range players {
+			r := rating(player)
+			r.Rating += changes[player]
+			r.Games++
+			r.History = append(r.History, RatingChange{Game: key, Rating: roundRating(r.Rating), Change: roundRating(changes[player])})
+		}
+	}
+
+	out := make([]PlayerRating, 0, len(ratings))
+	for _, r := range ratings {
+		r.Rating = roundRating(r.Rating)
+		out = append(out, *r)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Rating != out[j].Rating {
+			return out[i].Rating > out[j].Rating
+		}
+		return out[i].Player < out[j].Player
+	})
+	return out
+}
+
+// ReadRatings reads the ratings written as JSON from the result of Ratings, to seed the next ones.
+func ReadRatings(r io.Reader) (map[string]float64, error) {
+	var ratings []PlayerRating
+	if err := json.NewDecoder(r).Decode(&ratings); err != nil {
+		return nil, err
+	}
+
+	seed := make(map[string]float64, len(ratings))
+	for _, rating := range ratings {
+		seed[rating.Player] = rating.Rating
+	}
+	return seed, nil
+}
+
+func roundRating(rating float64) float64 {
+	return math.Round(rating*100) / 100
+}
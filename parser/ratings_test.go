@@ -0,0 +1,111 @@
+//go:build unit
+
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRatings(t *testing.T) {
+	tests := []struct {
+		name  string
+		games map[string]Game
+		seed  map[string]float64
+		want  []PlayerRating
+	}{
+		{
+			name:  "No games",
+			games: map[string]Game{},
+			want:  []PlayerRating{},
+		},
+		{
+			name: "Share of the kills",
+			games: map[string]Game{
+				"game_01": {
+					Players:    []string{"Zeh", "Mal", "Isgalamido"},
+					KillMatrix: KillMatrix{"Zeh": {"Mal": 3, "Zeh": 2}, "Mal": {"Zeh": 1}},
+				},
+			},
+			want: []PlayerRating{
+				{Player: "Zeh", Rating: 1504, Games: 1, History: []RatingChange{{Game: "game_01", Rating: 1504, Change: 4}}},
+				{Player: "Isgalamido", Rating: 1500, Games: 1, History: []RatingChange{{Game: "game_01", Rating: 1500, Change: 0}}},
+				{Player: "Mal", Rating: 1496, Games: 1, History: []RatingChange{{Game: "game_01", Rating: 1496, Change: -4}}},
+			},
+		},
+		{
+			name: "Seeded",
+			games: map[string]Game{
+				"game_01": {
+					Players:    []string{"Zeh", "Mal"},
+					KillMatrix: KillMatrix{"Zeh": {"Mal": 1}},
+				},
+			},
+			seed: map[string]float64{"Zeh": 1600, "Mal": 1400, "Dono da Bola": 1550},
+			want: []PlayerRating{
+				{Player: "Zeh", Rating: 1603.84, Games: 1, History: []RatingChange{{Game: "game_01", Rating: 1603.84, Change: 3.84}}},
+				{Player: "Dono da Bola", Rating: 1550, Games: 0, History: []RatingChange{}},
+				{Player: "Mal", Rating: 1396.16, Games: 1, History: []RatingChange{{Game: "game_01", Rating: 1396.16, Change: -3.84}}},
+			},
+		},
+		{
+			name: "Games in order",
+			games: map[string]Game{
+				"game_10": {
+					Players:    []string{"Zeh", "Mal"},
+					KillMatrix: KillMatrix{"Mal": {"Zeh": 1}},
+				},
+				"game_02": {
+					Players:    []string{"Zeh", "Mal"},
+					KillMatrix: KillMatrix{"Zeh": {"Mal": 1}},
+				},
+			},
+			want: []PlayerRating{
+				{Player: "Mal", Rating: 1500.37, Games: 2, History: []RatingChange{
+					{Game: "game_02", Rating: 1492, Change: -8},
+					{Game: "game_10", Rating: 1500.37, Change: 8.37},
+				}},
+				{Player: "Zeh", Rating: 1499.63, Games: 2, History: []RatingChange{
+					{Game: "game_02", Rating: 1508, Change: 8},
+					{Game: "game_10", Rating: 1499.63, Change: -8.37},
+				}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Ratings(tt.games, tt.seed))
+		})
+	}
+}
+
+func TestRatings_reproducible(t *testing.T) {
+	games, err := (&Parser{}).ParseGames("./test/Parse_1.log")
+	require.NoError(t, err)
+
+	want := Ratings(games, nil)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, want, Ratings(games, nil))
+	}
+}
+
+func TestReadRatings(t *testing.T) {
+	ratings := Ratings(map[string]Game{
+		"game_01": {Players: []string{"Zeh", "Mal"}, KillMatrix: KillMatrix{"Zeh": {"Mal": 1}}},
+	}, nil)
+
+	var buf bytes.Buffer
+	require.NoError(t, json.NewEncoder(&buf).Encode(ratings))
+
+	seed, err := ReadRatings(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{"Zeh": 1508, "Mal": 1492}, seed)
+
+	_, err = ReadRatings(strings.NewReader(`{"Zeh": 1508}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,71 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ScoringRules are the points the players score in Kills, by kill and by death.
+type ScoringRules struct {
+	// Kill is scored by the killer of another player.
+	Kill int `json:"kill" yaml:"kill"`
+	// WorldDeath is scored by the players killed by <world>.
+	WorldDeath int `json:"world_death" yaml:"world_death"`
+	// Suicide is scored by the players killing themselves.
+	Suicide int `json:"suicide" yaml:"suicide"`
+	// TeamKill is scored by the killer of a teammate, in team games.
+	TeamKill int `json:"team_kill" yaml:"team_kill"`
+	// KeepZero keeps the players back to a zero score in Kills.
+	KeepZero bool `json:"keep_zero" yaml:"keep_zero"`
+}
+
+// DefaultScoringRules returns the rules of a parser without any: a point by kill, team kills
+// included, a point less by death to <world>, suicides ignored, zero scores dropped.
+func DefaultScoringRules() ScoringRules {
+	return ScoringRules{Kill: 1, WorldDeath: -1, TeamKill: 1}
+}
+
+// ReadScoringRules reads scoring rules in YAML or JSON, the rules left out keeping their defaults:
+//
+//	suicide: -1
+//	team_kill: -1
+func ReadScoringRules(r io.Reader) (ScoringRules, error) {
+	rules := DefaultScoringRules()
+	if err := yaml.NewDecoder(r).Decode(&rules); err != nil && err != io.EOF {
+		return ScoringRules{}, fmt.Errorf("invalid scoring rules: %w", err)
+	}
+	return rules, nil
+}
+
+// LoadScoringRules reads the scoring rules of the file.
+func LoadScoringRules(filename string) (ScoringRules, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return ScoringRules{}, err
+	}
+	defer f.Close()
+
+	return ReadScoringRules(f)
+}
+
+// scoringRules returns the rules of the parser, the default ones when it has none.
+func (p *Parser) scoringRules() ScoringRules {
+	if p.Scoring == nil {
+		return DefaultScoringRules()
+	}
+	return *p.Scoring
+}
+
+// teamKill reports whether the killer and the victim play in the same team of a team game.
+func (p *Parser) teamKill(killer, victim string) bool {
+	teams := p.log[p.gameKey()].Teams
+	if teams == nil || killer == victim {
+		return false
+	}
+
+	team := teams.Players[killer]
+	return (team == TeamRed || team == TeamBlue) && teams.Players[victim] == team
+}
@@ -0,0 +1,97 @@
+//go:build unit
+
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadScoringRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		want    ScoringRules
+		wantErr bool
+	}{
+		{
+			name:   "Defaults",
+			config: "",
+			want:   ScoringRules{Kill: 1, WorldDeath: -1, TeamKill: 1},
+		},
+		{
+			name:   "YAML",
+			config: "suicide: -1\nteam_kill: -1\nkeep_zero: true\n",
+			want:   ScoringRules{Kill: 1, WorldDeath: -1, Suicide: -1, TeamKill: -1, KeepZero: true},
+		},
+		{
+			name:   "JSON",
+			config: `{"kill": 2, "world_death": 0}`,
+			want:   ScoringRules{Kill: 2, TeamKill: 1},
+		},
+		{
+			name:    "Not a number",
+			config:  "kill: many\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadScoringRules(strings.NewReader(tt.config))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParser_ParseLine_scoring(t *testing.T) {
+	lines := []string{
+		`  0:00 InitGame: \sv_hostname\Code Miner Server\g_gametype\4\mapname\q3ctf1`,
+		`  0:01 ClientUserinfoChanged: 2 n\Isgalamido\t\1\model\sarge`,
+		`  0:01 ClientUserinfoChanged: 3 n\Zeh\t\1\model\sarge`,
+		`  0:01 ClientUserinfoChanged: 4 n\Mal\t\2\model\sarge`,
+		`  0:02 Kill: 2 4 10: Isgalamido killed Mal by MOD_RAILGUN`,
+		`  0:03 Kill: 2 3 10: Isgalamido killed Zeh by MOD_RAILGUN`,
+		`  0:04 Kill: 4 4 7: Mal killed Mal by MOD_ROCKET_SPLASH`,
+		`  0:05 Kill: 1022 3 22: <world> killed Zeh by MOD_TRIGGER_HURT`,
+		`  0:06 Kill: 1022 3 22: <world> killed Zeh by MOD_TRIGGER_HURT`,
+	}
+
+	tests := []struct {
+		name    string
+		scoring *ScoringRules
+		want    map[string]int
+	}{
+		{
+			name: "Default rules",
+			want: map[string]int{"Isgalamido": 2, "Zeh": -2},
+		},
+		{
+			name:    "League rules",
+			scoring: &ScoringRules{Kill: 2, WorldDeath: -1, Suicide: -2, TeamKill: -2},
+			want:    map[string]int{"Mal": -2, "Zeh": -2},
+		},
+		{
+			name:    "Zero scores kept",
+			scoring: &ScoringRules{Kill: 1, WorldDeath: -1, TeamKill: -1, KeepZero: true},
+			want:    map[string]int{"Isgalamido": 0, "Mal": 0, "Zeh": -2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Parser{Scoring: tt.scoring}
+			for _, line := range lines {
+				p.ParseLine(line)
+			}
+			p.End()
+
+			assert.Equal(t, tt.want, p.log["game_01"].Kills)
+		})
+	}
+}
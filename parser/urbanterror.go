@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// UrbanTerror is the dialect of Urban Terror, whose say lines log the client of the player, and of
+// the player a saytell is sent to, whose means of death, UT_MOD_*, are numbered apart from ioq3's,
+// and whose flag lines log the drops, returns and captures apart from the pickups.
+type UrbanTerror struct {
+	Baseq3
+}
+
+// Urban Terror game types.
+const (
+	urtGameTypeTeam = 3
+	urtGameTypeCTF  = 7
+	urtGameTypeJump = 9
+	urtGameTypeGun  = 11
+)
+
+var (
+	urtSayLine  = regexp.MustCompile(`^\s*\d+:\d{2} (?:say|sayteam|saytell): `)
+	urtSayText  = regexp.MustCompile(`^\s*\d+:\d{2} (say|sayteam): (\d+) (.*)$`)
+	urtTellText = regexp.MustCompile(`^\s*\d+:\d{2} saytell: (\d+) (\d+) (.*)$`)
+	urtFlagLine = regexp.MustCompile(`^\s*\d+:\d{2} Flag: `)
+	urtFlagText = regexp.MustCompile(`Flag: (\d+) (\d+): team_CTF_(red|blue)flag`)
+)
+
+// urtFlagActions are the flag actions of the Flag lines, by ID.
+var urtFlagActions = map[string]FlagAction{"0": FlagDropped, "1": FlagReturned, "2": FlagCaptured}
+
+func (UrbanTerror) Name() string {
+	return "urbanterror"
+}
+
+func (UrbanTerror) Detect(gameName, version string) bool {
+	return strings.HasPrefix(gameName, "q3ut") || strings.HasPrefix(gameName, "q3urt") || strings.Contains(version, " urt ")
+}
+
+func (d UrbanTerror) Recognize(line string) LineKind {
+	if urtSayLine.MatchString(line) {
+		return LineSay
+	}
+	if urtFlagLine.MatchString(line) {
+		return LineFlag
+	}
+	return d.Baseq3.Recognize(line)
+}
+
+func (d UrbanTerror) Decode(kind LineKind, line string) (Line, bool) {
+	switch kind {
+	case LineSay:
+		if matches := urtTellText.FindStringSubmatch(line); matches != nil {
+			name, message, found := strings.Cut(matches[3], ": ")
+			if !found || name == "" {
+				return Line{}, false
+			}
+			return Line{ClientID: matches[1], ToClientID: matches[2], Text: matches[3], Name: name, Message: message}, true
+		}
+		matches := urtSayText.FindStringSubmatch(line)
+		if matches == nil {
+			return Line{}, false
+		}
+		name, message, found := strings.Cut(matches[3], ": ")
+		if !found || name == "" {
+			return Line{}, false
+		}
+		return Line{ClientID: matches[2], Text: matches[3], Name: name, Message: message, TeamChat: matches[1] == "sayteam"}, true
+	case LineKill:
+		// The means of death are only known by the names logged.
+		return decodeKill(line)
+	case LineFlag:
+		if matches := urtFlagText.FindStringSubmatch(line); matches != nil {
+			action, ok := urtFlagActions[matches[2]]
+			if !ok {
+				return Line{}, false
+			}
+			return Line{ClientID: matches[1], Flag: matches[3], FlagAction: action}, true
+		}
+		// The flags are picked up as items, their other touches being logged as Flag lines.
+		flag, ok := decodeFlagItem(line)
+		if !ok {
+			return Line{}, false
+		}
+		flag.FlagAction = FlagPickedUp
+		return flag, true
+	}
+	return d.Baseq3.Decode(kind, line)
+}
+
+func (UrbanTerror) GameType(gameType int) (teams, ctf bool) {
+	teams = gameType >= urtGameTypeTeam && gameType != urtGameTypeJump && gameType != urtGameTypeGun
+	return teams, gameType == urtGameTypeCTF
+}
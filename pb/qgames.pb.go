@@ -0,0 +1,1428 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: qgames.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ParseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The log file content.
+	Log           []byte `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseRequest) Reset() {
+	*x = ParseRequest{}
+	mi := &file_qgames_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseRequest) ProtoMessage() {}
+
+func (x *ParseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseRequest.ProtoReflect.Descriptor instead.
+func (*ParseRequest) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ParseRequest) GetLog() []byte {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+type ParseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The games of the log, keyed by game_XX.
+	Games         map[string]*Game `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseResponse) Reset() {
+	*x = ParseResponse{}
+	mi := &file_qgames_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseResponse) ProtoMessage() {}
+
+func (x *ParseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseResponse.ProtoReflect.Descriptor instead.
+func (*ParseResponse) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParseResponse) GetGames() map[string]*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type StreamEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The log file content.
+	Log []byte `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	// Only stream the events of this game, e.g. game_01, optional.
+	Game string `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
+	// Only stream the events involving this player, optional.
+	Player        string `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_qgames_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StreamEventsRequest) GetLog() []byte {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+func (x *StreamEventsRequest) GetGame() string {
+	if x != nil {
+		return x.Game
+	}
+	return ""
+}
+
+func (x *StreamEventsRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+type Game struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TotalKills      int32                  `protobuf:"varint,1,opt,name=total_kills,json=totalKills,proto3" json:"total_kills,omitempty"`
+	Players         []string               `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	Kills           map[string]int32       `protobuf:"bytes,3,rep,name=kills,proto3" json:"kills,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	KillsByMeans    map[string]int32       `protobuf:"bytes,4,rep,name=kills_by_means,json=killsByMeans,proto3" json:"kills_by_means,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ItemsByCategory map[string]int32       `protobuf:"bytes,5,rep,name=items_by_category,json=itemsByCategory,proto3" json:"items_by_category,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ItemsByPlayer   map[string]*ItemCounts `protobuf:"bytes,6,rep,name=items_by_player,json=itemsByPlayer,proto3" json:"items_by_player,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Chat            []*Message             `protobuf:"bytes,7,rep,name=chat,proto3" json:"chat,omitempty"`
+	Connections     map[string]*Connection `protobuf:"bytes,8,rep,name=connections,proto3" json:"connections,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Only set for team games.
+	Teams *Teams `protobuf:"bytes,9,opt,name=teams,proto3" json:"teams,omitempty"`
+	// Only set for capture the flag games.
+	Ctf    *CTF    `protobuf:"bytes,10,opt,name=ctf,proto3" json:"ctf,omitempty"`
+	Map    string  `protobuf:"bytes,11,opt,name=map,proto3" json:"map,omitempty"`
+	Awards *Awards `protobuf:"bytes,12,opt,name=awards,proto3" json:"awards,omitempty"`
+	// Kills between players, by killer then by victim.
+	KillMatrix map[string]*Counts `protobuf:"bytes,13,rep,name=kill_matrix,json=killMatrix,proto3" json:"kill_matrix,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Only set when counting the kills by weapon family.
+	KillsByFamily map[string]int32 `protobuf:"bytes,14,rep,name=kills_by_family,json=killsByFamily,proto3" json:"kills_by_family,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Kill lines whose names disagree with their IDs.
+	Inconsistencies []*Inconsistency       `protobuf:"bytes,15,rep,name=inconsistencies,proto3" json:"inconsistencies,omitempty"`
+	Names           map[string]*PlayerName `protobuf:"bytes,16,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The results of the custom aggregators, by key, encoded in JSON.
+	Aggregates    map[string]string `protobuf:"bytes,17,rep,name=aggregates,proto3" json:"aggregates,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	mi := &file_qgames_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Game) GetTotalKills() int32 {
+	if x != nil {
+		return x.TotalKills
+	}
+	return 0
+}
+
+func (x *Game) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Game) GetKills() map[string]int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return nil
+}
+
+func (x *Game) GetKillsByMeans() map[string]int32 {
+	if x != nil {
+		return x.KillsByMeans
+	}
+	return nil
+}
+
+func (x *Game) GetItemsByCategory() map[string]int32 {
+	if x != nil {
+		return x.ItemsByCategory
+	}
+	return nil
+}
+
+func (x *Game) GetItemsByPlayer() map[string]*ItemCounts {
+	if x != nil {
+		return x.ItemsByPlayer
+	}
+	return nil
+}
+
+func (x *Game) GetChat() []*Message {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *Game) GetConnections() map[string]*Connection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *Game) GetTeams() *Teams {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *Game) GetCtf() *CTF {
+	if x != nil {
+		return x.Ctf
+	}
+	return nil
+}
+
+func (x *Game) GetMap() string {
+	if x != nil {
+		return x.Map
+	}
+	return ""
+}
+
+func (x *Game) GetAwards() *Awards {
+	if x != nil {
+		return x.Awards
+	}
+	return nil
+}
+
+func (x *Game) GetKillMatrix() map[string]*Counts {
+	if x != nil {
+		return x.KillMatrix
+	}
+	return nil
+}
+
+func (x *Game) GetKillsByFamily() map[string]int32 {
+	if x != nil {
+		return x.KillsByFamily
+	}
+	return nil
+}
+
+func (x *Game) GetInconsistencies() []*Inconsistency {
+	if x != nil {
+		return x.Inconsistencies
+	}
+	return nil
+}
+
+func (x *Game) GetNames() map[string]*PlayerName {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *Game) GetAggregates() map[string]string {
+	if x != nil {
+		return x.Aggregates
+	}
+	return nil
+}
+
+type PlayerName struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName   string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	CleanName     string                 `protobuf:"bytes,2,opt,name=clean_name,json=cleanName,proto3" json:"clean_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerName) Reset() {
+	*x = PlayerName{}
+	mi := &file_qgames_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerName) ProtoMessage() {}
+
+func (x *PlayerName) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerName.ProtoReflect.Descriptor instead.
+func (*PlayerName) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PlayerName) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *PlayerName) GetCleanName() string {
+	if x != nil {
+		return x.CleanName
+	}
+	return ""
+}
+
+type Inconsistency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int32                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Logged        string                 `protobuf:"bytes,2,opt,name=logged,proto3" json:"logged,omitempty"`
+	Resolved      string                 `protobuf:"bytes,3,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Inconsistency) Reset() {
+	*x = Inconsistency{}
+	mi := &file_qgames_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Inconsistency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inconsistency) ProtoMessage() {}
+
+func (x *Inconsistency) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inconsistency.ProtoReflect.Descriptor instead.
+func (*Inconsistency) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Inconsistency) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Inconsistency) GetLogged() string {
+	if x != nil {
+		return x.Logged
+	}
+	return ""
+}
+
+func (x *Inconsistency) GetResolved() string {
+	if x != nil {
+		return x.Resolved
+	}
+	return ""
+}
+
+type ItemCounts struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Item pickups by category.
+	Counts        map[string]int32 `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemCounts) Reset() {
+	*x = ItemCounts{}
+	mi := &file_qgames_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemCounts) ProtoMessage() {}
+
+func (x *ItemCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemCounts.ProtoReflect.Descriptor instead.
+func (*ItemCounts) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ItemCounts) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type Message struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Player  string                 `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	Message string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Team    bool                   `protobuf:"varint,4,opt,name=team,proto3" json:"team,omitempty"`
+	To      string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// Seconds of game time.
+	Time          int32 `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_qgames_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Message) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *Message) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Message) GetTeam() bool {
+	if x != nil {
+		return x.Team
+	}
+	return false
+}
+
+func (x *Message) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Message) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// Times are in seconds of game time.
+type Connection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JoinedAt      int32                  `protobuf:"varint,1,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	LeftAt        int32                  `protobuf:"varint,2,opt,name=left_at,json=leftAt,proto3" json:"left_at,omitempty"`
+	TimeOnServer  int32                  `protobuf:"varint,3,opt,name=time_on_server,json=timeOnServer,proto3" json:"time_on_server,omitempty"`
+	Reconnects    int32                  `protobuf:"varint,4,opt,name=reconnects,proto3" json:"reconnects,omitempty"`
+	PresentAtEnd  bool                   `protobuf:"varint,5,opt,name=present_at_end,json=presentAtEnd,proto3" json:"present_at_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Connection) Reset() {
+	*x = Connection{}
+	mi := &file_qgames_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Connection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connection) ProtoMessage() {}
+
+func (x *Connection) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connection.ProtoReflect.Descriptor instead.
+func (*Connection) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Connection) GetJoinedAt() int32 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+func (x *Connection) GetLeftAt() int32 {
+	if x != nil {
+		return x.LeftAt
+	}
+	return 0
+}
+
+func (x *Connection) GetTimeOnServer() int32 {
+	if x != nil {
+		return x.TimeOnServer
+	}
+	return 0
+}
+
+func (x *Connection) GetReconnects() int32 {
+	if x != nil {
+		return x.Reconnects
+	}
+	return 0
+}
+
+func (x *Connection) GetPresentAtEnd() bool {
+	if x != nil {
+		return x.PresentAtEnd
+	}
+	return false
+}
+
+type Awards struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FirstBlood string                 `protobuf:"bytes,1,opt,name=first_blood,json=firstBlood,proto3" json:"first_blood,omitempty"`
+	// Longest streak of each player, in kills without dying.
+	Streaks    map[string]int32       `protobuf:"bytes,2,rep,name=streaks,proto3" json:"streaks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	MultiKills map[string]*MultiKills `protobuf:"bytes,3,rep,name=multi_kills,json=multiKills,proto3" json:"multi_kills,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Longest run of kills of each victim, by killer.
+	Dominations   map[string]*Counts `protobuf:"bytes,4,rep,name=dominations,proto3" json:"dominations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Awards) Reset() {
+	*x = Awards{}
+	mi := &file_qgames_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Awards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Awards) ProtoMessage() {}
+
+func (x *Awards) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Awards.ProtoReflect.Descriptor instead.
+func (*Awards) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Awards) GetFirstBlood() string {
+	if x != nil {
+		return x.FirstBlood
+	}
+	return ""
+}
+
+func (x *Awards) GetStreaks() map[string]int32 {
+	if x != nil {
+		return x.Streaks
+	}
+	return nil
+}
+
+func (x *Awards) GetMultiKills() map[string]*MultiKills {
+	if x != nil {
+		return x.MultiKills
+	}
+	return nil
+}
+
+func (x *Awards) GetDominations() map[string]*Counts {
+	if x != nil {
+		return x.Dominations
+	}
+	return nil
+}
+
+type MultiKills struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Double int32                  `protobuf:"varint,1,opt,name=double,proto3" json:"double,omitempty"`
+	Triple int32                  `protobuf:"varint,2,opt,name=triple,proto3" json:"triple,omitempty"`
+	// Series of four kills or more.
+	Quad          int32 `protobuf:"varint,3,opt,name=quad,proto3" json:"quad,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiKills) Reset() {
+	*x = MultiKills{}
+	mi := &file_qgames_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiKills) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiKills) ProtoMessage() {}
+
+func (x *MultiKills) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiKills.ProtoReflect.Descriptor instead.
+func (*MultiKills) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MultiKills) GetDouble() int32 {
+	if x != nil {
+		return x.Double
+	}
+	return 0
+}
+
+func (x *MultiKills) GetTriple() int32 {
+	if x != nil {
+		return x.Triple
+	}
+	return 0
+}
+
+func (x *MultiKills) GetQuad() int32 {
+	if x != nil {
+		return x.Quad
+	}
+	return 0
+}
+
+type Counts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        map[string]int32       `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Counts) Reset() {
+	*x = Counts{}
+	mi := &file_qgames_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Counts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counts) ProtoMessage() {}
+
+func (x *Counts) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counts.ProtoReflect.Descriptor instead.
+func (*Counts) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Counts) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type Teams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       map[string]string      `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Switches      map[string]int32       `protobuf:"bytes,2,rep,name=switches,proto3" json:"switches,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Kills         map[string]int32       `protobuf:"bytes,3,rep,name=kills,proto3" json:"kills,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	TeamKills     map[string]int32       `protobuf:"bytes,4,rep,name=team_kills,json=teamKills,proto3" json:"team_kills,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Scores        map[string]int32       `protobuf:"bytes,5,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Teams) Reset() {
+	*x = Teams{}
+	mi := &file_qgames_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Teams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Teams) ProtoMessage() {}
+
+func (x *Teams) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Teams.ProtoReflect.Descriptor instead.
+func (*Teams) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Teams) GetPlayers() map[string]string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Teams) GetSwitches() map[string]int32 {
+	if x != nil {
+		return x.Switches
+	}
+	return nil
+}
+
+func (x *Teams) GetKills() map[string]int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return nil
+}
+
+func (x *Teams) GetTeamKills() map[string]int32 {
+	if x != nil {
+		return x.TeamKills
+	}
+	return nil
+}
+
+func (x *Teams) GetScores() map[string]int32 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type CTF struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       map[string]*FlagStats  `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Teams         map[string]*FlagStats  `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CTF) Reset() {
+	*x = CTF{}
+	mi := &file_qgames_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CTF) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTF) ProtoMessage() {}
+
+func (x *CTF) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTF.ProtoReflect.Descriptor instead.
+func (*CTF) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CTF) GetPlayers() map[string]*FlagStats {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *CTF) GetTeams() map[string]*FlagStats {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type FlagStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pickups       int32                  `protobuf:"varint,1,opt,name=pickups,proto3" json:"pickups,omitempty"`
+	Captures      int32                  `protobuf:"varint,2,opt,name=captures,proto3" json:"captures,omitempty"`
+	Returns       int32                  `protobuf:"varint,3,opt,name=returns,proto3" json:"returns,omitempty"`
+	CarrierKills  int32                  `protobuf:"varint,4,opt,name=carrier_kills,json=carrierKills,proto3" json:"carrier_kills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagStats) Reset() {
+	*x = FlagStats{}
+	mi := &file_qgames_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagStats) ProtoMessage() {}
+
+func (x *FlagStats) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagStats.ProtoReflect.Descriptor instead.
+func (*FlagStats) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FlagStats) GetPickups() int32 {
+	if x != nil {
+		return x.Pickups
+	}
+	return 0
+}
+
+func (x *FlagStats) GetCaptures() int32 {
+	if x != nil {
+		return x.Captures
+	}
+	return 0
+}
+
+func (x *FlagStats) GetReturns() int32 {
+	if x != nil {
+		return x.Returns
+	}
+	return 0
+}
+
+func (x *FlagStats) GetCarrierKills() int32 {
+	if x != nil {
+		return x.CarrierKills
+	}
+	return 0
+}
+
+// Event is a game event, its time in seconds of game time.
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Game          string                 `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
+	Time          int32                  `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Player        string                 `protobuf:"bytes,4,opt,name=player,proto3" json:"player,omitempty"`
+	Killer        string                 `protobuf:"bytes,5,opt,name=killer,proto3" json:"killer,omitempty"`
+	Victim        string                 `protobuf:"bytes,6,opt,name=victim,proto3" json:"victim,omitempty"`
+	Means         string                 `protobuf:"bytes,7,opt,name=means,proto3" json:"means,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_qgames_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_qgames_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_qgames_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetGame() string {
+	if x != nil {
+		return x.Game
+	}
+	return ""
+}
+
+func (x *Event) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Event) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *Event) GetKiller() string {
+	if x != nil {
+		return x.Killer
+	}
+	return ""
+}
+
+func (x *Event) GetVictim() string {
+	if x != nil {
+		return x.Victim
+	}
+	return ""
+}
+
+func (x *Event) GetMeans() string {
+	if x != nil {
+		return x.Means
+	}
+	return ""
+}
+
+var File_qgames_proto protoreflect.FileDescriptor
+
+const file_qgames_proto_rawDesc = "" +
+	"\n" +
+	"\fqgames.proto\x12\x06qgames\" \n" +
+	"\fParseRequest\x12\x10\n" +
+	"\x03log\x18\x01 \x01(\fR\x03log\"\x8f\x01\n" +
+	"\rParseResponse\x126\n" +
+	"\x05games\x18\x01 \x03(\v2 .qgames.ParseResponse.GamesEntryR\x05games\x1aF\n" +
+	"\n" +
+	"GamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\"\n" +
+	"\x05value\x18\x02 \x01(\v2\f.qgames.GameR\x05value:\x028\x01\"S\n" +
+	"\x13StreamEventsRequest\x12\x10\n" +
+	"\x03log\x18\x01 \x01(\fR\x03log\x12\x12\n" +
+	"\x04game\x18\x02 \x01(\tR\x04game\x12\x16\n" +
+	"\x06player\x18\x03 \x01(\tR\x06player\"\xef\v\n" +
+	"\x04Game\x12\x1f\n" +
+	"\vtotal_kills\x18\x01 \x01(\x05R\n" +
+	"totalKills\x12\x18\n" +
+	"\aplayers\x18\x02 \x03(\tR\aplayers\x12-\n" +
+	"\x05kills\x18\x03 \x03(\v2\x17.qgames.Game.KillsEntryR\x05kills\x12D\n" +
+	"\x0ekills_by_means\x18\x04 \x03(\v2\x1e.qgames.Game.KillsByMeansEntryR\fkillsByMeans\x12M\n" +
+	"\x11items_by_category\x18\x05 \x03(\v2!.qgames.Game.ItemsByCategoryEntryR\x0fitemsByCategory\x12G\n" +
+	"\x0fitems_by_player\x18\x06 \x03(\v2\x1f.qgames.Game.ItemsByPlayerEntryR\ritemsByPlayer\x12#\n" +
+	"\x04chat\x18\a \x03(\v2\x0f.qgames.MessageR\x04chat\x12?\n" +
+	"\vconnections\x18\b \x03(\v2\x1d.qgames.Game.ConnectionsEntryR\vconnections\x12#\n" +
+	"\x05teams\x18\t \x01(\v2\r.qgames.TeamsR\x05teams\x12\x1d\n" +
+	"\x03ctf\x18\n" +
+	" \x01(\v2\v.qgames.CTFR\x03ctf\x12\x10\n" +
+	"\x03map\x18\v \x01(\tR\x03map\x12&\n" +
+	"\x06awards\x18\f \x01(\v2\x0e.qgames.AwardsR\x06awards\x12=\n" +
+	"\vkill_matrix\x18\r \x03(\v2\x1c.qgames.Game.KillMatrixEntryR\n" +
+	"killMatrix\x12G\n" +
+	"\x0fkills_by_family\x18\x0e \x03(\v2\x1f.qgames.Game.KillsByFamilyEntryR\rkillsByFamily\x12?\n" +
+	"\x0finconsistencies\x18\x0f \x03(\v2\x15.qgames.InconsistencyR\x0finconsistencies\x12-\n" +
+	"\x05names\x18\x10 \x03(\v2\x17.qgames.Game.NamesEntryR\x05names\x12<\n" +
+	"\n" +
+	"aggregates\x18\x11 \x03(\v2\x1c.qgames.Game.AggregatesEntryR\n" +
+	"aggregates\x1a8\n" +
+	"\n" +
+	"KillsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a?\n" +
+	"\x11KillsByMeansEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aB\n" +
+	"\x14ItemsByCategoryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aT\n" +
+	"\x12ItemsByPlayerEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.qgames.ItemCountsR\x05value:\x028\x01\x1aR\n" +
+	"\x10ConnectionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.qgames.ConnectionR\x05value:\x028\x01\x1aM\n" +
+	"\x0fKillMatrixEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12$\n" +
+	"\x05value\x18\x02 \x01(\v2\x0e.qgames.CountsR\x05value:\x028\x01\x1a@\n" +
+	"\x12KillsByFamilyEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aL\n" +
+	"\n" +
+	"NamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.qgames.PlayerNameR\x05value:\x028\x01\x1a=\n" +
+	"\x0fAggregatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"N\n" +
+	"\n" +
+	"PlayerName\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x1d\n" +
+	"\n" +
+	"clean_name\x18\x02 \x01(\tR\tcleanName\"W\n" +
+	"\rInconsistency\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\x05R\x04time\x12\x16\n" +
+	"\x06logged\x18\x02 \x01(\tR\x06logged\x12\x1a\n" +
+	"\bresolved\x18\x03 \x01(\tR\bresolved\"\x7f\n" +
+	"\n" +
+	"ItemCounts\x126\n" +
+	"\x06counts\x18\x01 \x03(\v2\x1e.qgames.ItemCounts.CountsEntryR\x06counts\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"y\n" +
+	"\aMessage\x12\x16\n" +
+	"\x06player\x18\x02 \x01(\tR\x06player\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x12\n" +
+	"\x04team\x18\x04 \x01(\bR\x04team\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12\x12\n" +
+	"\x04time\x18\x06 \x01(\x05R\x04timeJ\x04\b\x01\x10\x02\"\xae\x01\n" +
+	"\n" +
+	"Connection\x12\x1b\n" +
+	"\tjoined_at\x18\x01 \x01(\x05R\bjoinedAt\x12\x17\n" +
+	"\aleft_at\x18\x02 \x01(\x05R\x06leftAt\x12$\n" +
+	"\x0etime_on_server\x18\x03 \x01(\x05R\ftimeOnServer\x12\x1e\n" +
+	"\n" +
+	"reconnects\x18\x04 \x01(\x05R\n" +
+	"reconnects\x12$\n" +
+	"\x0epresent_at_end\x18\x05 \x01(\bR\fpresentAtEnd\"\xc3\x03\n" +
+	"\x06Awards\x12\x1f\n" +
+	"\vfirst_blood\x18\x01 \x01(\tR\n" +
+	"firstBlood\x125\n" +
+	"\astreaks\x18\x02 \x03(\v2\x1b.qgames.Awards.StreaksEntryR\astreaks\x12?\n" +
+	"\vmulti_kills\x18\x03 \x03(\v2\x1e.qgames.Awards.MultiKillsEntryR\n" +
+	"multiKills\x12A\n" +
+	"\vdominations\x18\x04 \x03(\v2\x1f.qgames.Awards.DominationsEntryR\vdominations\x1a:\n" +
+	"\fStreaksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aQ\n" +
+	"\x0fMultiKillsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.qgames.MultiKillsR\x05value:\x028\x01\x1aN\n" +
+	"\x10DominationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12$\n" +
+	"\x05value\x18\x02 \x01(\v2\x0e.qgames.CountsR\x05value:\x028\x01\"P\n" +
+	"\n" +
+	"MultiKills\x12\x16\n" +
+	"\x06double\x18\x01 \x01(\x05R\x06double\x12\x16\n" +
+	"\x06triple\x18\x02 \x01(\x05R\x06triple\x12\x12\n" +
+	"\x04quad\x18\x03 \x01(\x05R\x04quad\"w\n" +
+	"\x06Counts\x122\n" +
+	"\x06counts\x18\x01 \x03(\v2\x1a.qgames.Counts.CountsEntryR\x06counts\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xc2\x04\n" +
+	"\x05Teams\x124\n" +
+	"\aplayers\x18\x01 \x03(\v2\x1a.qgames.Teams.PlayersEntryR\aplayers\x127\n" +
+	"\bswitches\x18\x02 \x03(\v2\x1b.qgames.Teams.SwitchesEntryR\bswitches\x12.\n" +
+	"\x05kills\x18\x03 \x03(\v2\x18.qgames.Teams.KillsEntryR\x05kills\x12;\n" +
+	"\n" +
+	"team_kills\x18\x04 \x03(\v2\x1c.qgames.Teams.TeamKillsEntryR\tteamKills\x121\n" +
+	"\x06scores\x18\x05 \x03(\v2\x19.qgames.Teams.ScoresEntryR\x06scores\x1a:\n" +
+	"\fPlayersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rSwitchesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a8\n" +
+	"\n" +
+	"KillsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a<\n" +
+	"\x0eTeamKillsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a9\n" +
+	"\vScoresEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x83\x02\n" +
+	"\x03CTF\x122\n" +
+	"\aplayers\x18\x01 \x03(\v2\x18.qgames.CTF.PlayersEntryR\aplayers\x12,\n" +
+	"\x05teams\x18\x02 \x03(\v2\x16.qgames.CTF.TeamsEntryR\x05teams\x1aM\n" +
+	"\fPlayersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x05value\x18\x02 \x01(\v2\x11.qgames.FlagStatsR\x05value:\x028\x01\x1aK\n" +
+	"\n" +
+	"TeamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x05value\x18\x02 \x01(\v2\x11.qgames.FlagStatsR\x05value:\x028\x01\"\x80\x01\n" +
+	"\tFlagStats\x12\x18\n" +
+	"\apickups\x18\x01 \x01(\x05R\apickups\x12\x1a\n" +
+	"\bcaptures\x18\x02 \x01(\x05R\bcaptures\x12\x18\n" +
+	"\areturns\x18\x03 \x01(\x05R\areturns\x12#\n" +
+	"\rcarrier_kills\x18\x04 \x01(\x05R\fcarrierKills\"\xa1\x01\n" +
+	"\x05Event\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04game\x18\x02 \x01(\tR\x04game\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\x05R\x04time\x12\x16\n" +
+	"\x06player\x18\x04 \x01(\tR\x06player\x12\x16\n" +
+	"\x06killer\x18\x05 \x01(\tR\x06killer\x12\x16\n" +
+	"\x06victim\x18\x06 \x01(\tR\x06victim\x12\x14\n" +
+	"\x05means\x18\a \x01(\tR\x05means2|\n" +
+	"\x06QGames\x124\n" +
+	"\x05Parse\x12\x14.qgames.ParseRequest\x1a\x15.qgames.ParseResponse\x12<\n" +
+	"\fStreamEvents\x12\x1b.qgames.StreamEventsRequest\x1a\r.qgames.Event0\x01B\vZ\tqgames/pbb\x06proto3"
+
+var (
+	file_qgames_proto_rawDescOnce sync.Once
+	file_qgames_proto_rawDescData []byte
+)
+
+func file_qgames_proto_rawDescGZIP() []byte {
+	file_qgames_proto_rawDescOnce.Do(func() {
+		file_qgames_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_qgames_proto_rawDesc), len(file_qgames_proto_rawDesc)))
+	})
+	return file_qgames_proto_rawDescData
+}
+
+var file_qgames_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_qgames_proto_goTypes = []any{
+	(*ParseRequest)(nil),        // 0: qgames.ParseRequest
+	(*ParseResponse)(nil),       // 1: qgames.ParseResponse
+	(*StreamEventsRequest)(nil), // 2: qgames.StreamEventsRequest
+	(*Game)(nil),                // 3: qgames.Game
+	(*PlayerName)(nil),          // 4: qgames.PlayerName
+	(*Inconsistency)(nil),       // 5: qgames.Inconsistency
+	(*ItemCounts)(nil),          // 6: qgames.ItemCounts
+	(*Message)(nil),             // 7: qgames.Message
+	(*Connection)(nil),          // 8: qgames.Connection
+	(*Awards)(nil),              // 9: qgames.Awards
+	(*MultiKills)(nil),          // 10: qgames.MultiKills
+	(*Counts)(nil),              // 11: qgames.Counts
+	(*Teams)(nil),               // 12: qgames.Teams
+	(*CTF)(nil),                 // 13: qgames.CTF
+	(*FlagStats)(nil),           // 14: qgames.FlagStats
+	(*Event)(nil),               // 15: qgames.Event
+	nil,                         // 16: qgames.ParseResponse.GamesEntry
+	nil,                         // 17: qgames.Game.KillsEntry
+	nil,                         // 18: qgames.Game.KillsByMeansEntry
+	nil,                         // 19: qgames.Game.ItemsByCategoryEntry
+	nil,                         // 20: qgames.Game.ItemsByPlayerEntry
+	nil,                         // 21: qgames.Game.ConnectionsEntry
+	nil,                         // 22: qgames.Game.KillMatrixEntry
+	nil,                         // 23: qgames.Game.KillsByFamilyEntry
+	nil,                         // 24: qgames.Game.NamesEntry
+	nil,                         // 25: qgames.Game.AggregatesEntry
+	nil,                         // 26: qgames.ItemCounts.CountsEntry
+	nil,                         // 27: qgames.Awards.StreaksEntry
+	nil,                         // 28: qgames.Awards.MultiKillsEntry
+	nil,                         // 29: qgames.Awards.DominationsEntry
+	nil,                         // 30: qgames.Counts.CountsEntry
+	nil,                         // 31: qgames.Teams.PlayersEntry
+	nil,                         // 32: qgames.Teams.SwitchesEntry
+	nil,                         // 33: qgames.Teams.KillsEntry
+	nil,                         // 34: qgames.Teams.TeamKillsEntry
+	nil,                         // 35: qgames.Teams.ScoresEntry
+	nil,                         // 36: qgames.CTF.PlayersEntry
+	nil,                         // 37: qgames.CTF.TeamsEntry
+}
+var file_qgames_proto_depIdxs = []int32{
+	16, // 0: qgames.ParseResponse.games:type_name -> qgames.ParseResponse.GamesEntry
+	17, // 1: qgames.Game.kills:type_name -> qgames.Game.KillsEntry
+	18, // 2: qgames.Game.kills_by_means:type_name -> qgames.Game.KillsByMeansEntry
+	19, // 3: qgames.Game.items_by_category:type_name -> qgames.Game.ItemsByCategoryEntry
+	20, // 4: qgames.Game.items_by_player:type_name -> qgames.Game.ItemsByPlayerEntry
+	7,  // 5: qgames.Game.chat:type_name -> qgames.Message
+	21, // 6: qgames.Game.connections:type_name -> qgames.Game.ConnectionsEntry
+	12, // 7: qgames.Game.teams:type_name -> qgames.Teams
+	13, // 8: qgames.Game.ctf:type_name -> qgames.CTF
+	9,  // 9: qgames.Game.awards:type_name -> qgames.Awards
+	22, // 10: qgames.Game.kill_matrix:type_name -> qgames.Game.KillMatrixEntry
+	23, // 11: qgames.Game.kills_by_family:type_name -> qgames.Game.KillsByFamilyEntry
+	5,  // 12: qgames.Game.inconsistencies:type_name -> qgames.Inconsistency
+	24, // 13: qgames.Game.names:type_name -> qgames.Game.NamesEntry
+	25, // 14: qgames.Game.aggregates:type_name -> qgames.Game.AggregatesEntry
+	26, // 15: qgames.ItemCounts.counts:type_name -> qgames.ItemCounts.CountsEntry
+	27, // 16: qgames.Awards.streaks:type_name -> qgames.Awards.StreaksEntry
+	28, // 17: qgames.Awards.multi_kills:type_name -> qgames.Awards.MultiKillsEntry
+	29, // 18: qgames.Awards.dominations:type_name -> qgames.Awards.DominationsEntry
+	30, // 19: qgames.Counts.counts:type_name -> qgames.Counts.CountsEntry
+	31, // 20: qgames.Teams.players:type_name -> qgames.Teams.PlayersEntry
+	32, // 21: qgames.Teams.switches:type_name -> qgames.Teams.SwitchesEntry
+	33, // 22: qgames.Teams.kills:type_name -> qgames.Teams.KillsEntry
+	34, // 23: qgames.Teams.team_kills:type_name -> qgames.Teams.TeamKillsEntry
+	35, // 24: qgames.Teams.scores:type_name -> qgames.Teams.ScoresEntry
+	36, // 25: qgames.CTF.players:type_name -> qgames.CTF.PlayersEntry
+	37, // 26: qgames.CTF.teams:type_name -> qgames.CTF.TeamsEntry
+	3,  // 27: qgames.ParseResponse.GamesEntry.value:type_name -> qgames.Game
+	6,  // 28: qgames.Game.ItemsByPlayerEntry.value:type_name -> qgames.ItemCounts
+	8,  // 29: qgames.Game.ConnectionsEntry.value:type_name -> qgames.Connection
+	11, // 30: qgames.Game.KillMatrixEntry.value:type_name -> qgames.Counts
+	4,  // 31: qgames.Game.NamesEntry.value:type_name -> qgames.PlayerName
+	10, // 32: qgames.Awards.MultiKillsEntry.value:type_name -> qgames.MultiKills
+	11, // 33: qgames.Awards.DominationsEntry.value:type_name -> qgames.Counts
+	14, // 34: qgames.CTF.PlayersEntry.value:type_name -> qgames.FlagStats
+	14, // 35: qgames.CTF.TeamsEntry.value:type_name -> qgames.FlagStats
+	0,  // 36: qgames.QGames.Parse:input_type -> qgames.ParseRequest
+	2,  // 37: qgames.QGames.StreamEvents:input_type -> qgames.StreamEventsRequest
+	1,  // 38: qgames.QGames.Parse:output_type -> qgames.ParseResponse
+	15, // 39: qgames.QGames.StreamEvents:output_type -> qgames.Event
+	38, // [38:40] is the sub-list for method output_type
+	36, // [36:38] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_qgames_proto_init() }
+func file_qgames_proto_init() {
+	if File_qgames_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_qgames_proto_rawDesc), len(file_qgames_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   38,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_qgames_proto_goTypes,
+		DependencyIndexes: file_qgames_proto_depIdxs,
+		MessageInfos:      file_qgames_proto_msgTypes,
+	}.Build()
+	File_qgames_proto = out.File
+	file_qgames_proto_goTypes = nil
+	file_qgames_proto_depIdxs = nil
+}
@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: qgames.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	QGames_Parse_FullMethodName        = "/qgames.QGames/Parse"
+	QGames_StreamEvents_FullMethodName = "/qgames.QGames/StreamEvents"
+)
+
+// QGamesClient is the client API for QGames service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// QGames parses Quake 3 Arena logs.
+type QGamesClient interface {
+	// Parse parses a whole log into its games.
+	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	// StreamEvents parses a log, streaming its game events as they are parsed.
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+}
+
+type qGamesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQGamesClient(cc grpc.ClientConnInterface) QGamesClient {
+	return &qGamesClient{cc}
+}
+
+func (c *qGamesClient) Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, QGames_Parse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qGamesClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QGames_ServiceDesc.Streams[0], QGames_StreamEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamEventsRequest, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QGames_StreamEventsClient = grpc.ServerStreamingClient[Event]
+
+// QGamesServer is the server API for QGames service.
+// All implementations must embed UnimplementedQGamesServer
+// for forward compatibility.
+//
+// QGames parses Quake 3 Arena logs.
+type QGamesServer interface {
+	// Parse parses a whole log into its games.
+	Parse(context.Context, *ParseRequest) (*ParseResponse, error)
+	// StreamEvents parses a log, streaming its game events as they are parsed.
+	StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[Event]) error
+	mustEmbedUnimplementedQGamesServer()
+}
+
+// UnimplementedQGamesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQGamesServer struct{}
+
+func (UnimplementedQGamesServer) Parse(context.Context, *ParseRequest) (*ParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Parse not implemented")
+}
+func (UnimplementedQGamesServer) StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[Event]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedQGamesServer) mustEmbedUnimplementedQGamesServer() {}
+func (UnimplementedQGamesServer) testEmbeddedByValue()                {}
+
+// UnsafeQGamesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QGamesServer will
+// result in compilation errors.
+type UnsafeQGamesServer interface {
+	mustEmbedUnimplementedQGamesServer()
+}
+
+func RegisterQGamesServer(s grpc.ServiceRegistrar, srv QGamesServer) {
+	// If the following call pancis, it indicates UnimplementedQGamesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QGames_ServiceDesc, srv)
+}
+
+func _QGames_Parse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QGamesServer).Parse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QGames_Parse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QGamesServer).Parse(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QGames_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QGamesServer).StreamEvents(m, &grpc.GenericServerStream[StreamEventsRequest, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QGames_StreamEventsServer = grpc.ServerStreamingServer[Event]
+
+// QGames_ServiceDesc is the grpc.ServiceDesc for QGames service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QGames_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qgames.QGames",
+	HandlerType: (*QGamesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Parse",
+			Handler:    _QGames_Parse_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _QGames_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "qgames.proto",
+}
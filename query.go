@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"qgames/parser"
+)
+
+func query(args []string) {
+	// Define flags
+	var inFile string
+	var player string
+	var mapName string
+	var weapon string
+	var minKills int
+	var gameRange string
+	var stats bool
+	var aliasesFile string
+
+	// Parse command-line arguments
+	flags := flag.NewFlagSet("query", flag.ExitOnError)
+	flags.StringVar(&inFile, "in", "qgames.log", "Input file name")
+	flags.StringVar(&player, "player", "", "Only the games the player played, optional")
+	flags.StringVar(&mapName, "map", "", "Only the games played on the map, e.g. q3dm17, optional")
+	flags.StringVar(&weapon, "weapon", "", "Only the games with kills by the means of death or weapon family, e.g. MOD_RAILGUN or rocket_launcher, optional")
+	flags.IntVar(&minKills, "min-kills", 0, "Only the games with at least this many kills, by the player when set, optional")
+	flags.StringVar(&gameRange, "game", "", "Only the games in the range, e.g. 4..10, 4.., ..10 or 4, optional")
+	flags.BoolVar(&stats, "stats", false, "Print the ranking of the players over the matching games instead of the games")
+	flags.StringVar(&aliasesFile, "aliases", "", "Alias config, in YAML or JSON, naming the players by their canonical players, optional")
+	_ = flags.Parse(args)
+
+	games, err := parser.ParseGameRange(gameRange)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	p := parser.Parser{}
+	if aliasesFile != "" {
+		aliases, err := parser.LoadAliases(aliasesFile)
+		if err != nil {
+			panic(err)
+		}
+		p.Aliases = aliases
+		if canonical, ok := aliases.Player(player); ok {
+			player = canonical
+		}
+	}
+	parsed, err := p.ParseGames(inFile)
+	if err != nil {
+		panic(err)
+	}
+
+	matches := parser.Filter(parsed, parser.Query{
+		Player:   player,
+		Map:      mapName,
+		Weapon:   weapon,
+		MinKills: minKills,
+		Games:    games,
+	})
+
+	var out any = matches
+	if stats {
+		ranking := parser.Ranking(matches)
+		if player != "" {
+			ranking = playerRanking(ranking, player)
+		}
+		out = ranking
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(out); err != nil {
+		panic(err)
+	}
+}
+
+// playerRanking keeps only the entry of the player in the ranking.
+func playerRanking(ranking []parser.PlayerStats, player string) []parser.PlayerStats {
+	for _, stats := range ranking {
+		if stats.Player == player {
+			return []parser.PlayerStats{stats}
+		}
+	}
+	return []parser.PlayerStats{}
+}
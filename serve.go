@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"qgames/grpcserver"
+	"qgames/parser"
+	"qgames/server"
+)
+
+func serve(args []string) {
+	// Define flags
+	var inFile string
+	var addr string
+	var follow bool
+	var grpcAddr string
+
+	// Parse command-line arguments
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	flags.StringVar(&inFile, "in", "", "Input file name served until a log is uploaded, optional")
+	flags.StringVar(&addr, "addr", ":8080", "Address to listen on")
+	flags.BoolVar(&follow, "follow", false, "Keep parsing the input file as it grows, streaming its events on /events")
+	flags.StringVar(&grpcAddr, "grpc-addr", "", "Address to serve the gRPC service on, optional")
+	_ = flags.Parse(args)
+
+	metrics := parser.NewMetrics()
+	var games map[string]parser.Game
+	if inFile != "" && !follow {
+		p := parser.Parser{Metrics: metrics}
+		parsed, err := p.ParseGames(inFile)
+		if err != nil {
+			panic(err)
+		}
+		games = parsed
+	}
+
+	s := server.New(games)
+	s.Metrics = metrics
+	if inFile != "" && follow {
+		file, err := os.Open(inFile)
+		if err != nil {
+			panic(err)
+		}
+		defer file.Close()
+
+		go func() {
+			if err := s.Follow(context.Background(), server.Tail(context.Background(), file, time.Second)); err != nil {
+				log.Println(err)
+			}
+		}()
+	}
+
+	if grpcAddr != "" {
+		listener, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			panic(err)
+		}
+
+		grpcServer := grpc.NewServer()
+		grpcserver.Register(grpcServer, metrics)
+		go func() {
+			log.Printf("Serving gRPC on %s", grpcAddr)
+			if err := grpcServer.Serve(listener); err != nil {
+				log.Println(err)
+			}
+		}()
+	}
+
+	log.Printf("Listening on %s", addr)
+	if err := http.ListenAndServe(addr, s.Handler()); err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+}
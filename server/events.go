@@ -0,0 +1,129 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"qgames/parser"
+)
+
+// subscriberBuffer is how many events a slow subscriber can lag behind before events are dropped for it.
+const subscriberBuffer = 64
+
+type (
+	hub struct {
+		mu          sync.Mutex
+		subscribers map[*subscriber]struct{}
+	}
+
+	subscriber struct {
+		game   string
+		player string
+		events chan parser.Event
+	}
+)
+
+func newHub() *hub {
+	return &hub{subscribers: make(map[*subscriber]struct{})}
+}
+
+func (h *hub) subscribe(game, player string) *subscriber {
+	sub := &subscriber{game: game, player: player, events: make(chan parser.Event, subscriberBuffer)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (h *hub) unsubscribe(sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subscribers, sub)
+}
+
+// publish never blocks the parser: events are dropped for subscribers whose buffer is full.
+func (h *hub) publish(event parser.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers {
+		if !sub.wants(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+		}
+	}
+}
+
+func (s *subscriber) wants(event parser.Event) bool {
+	if s.game != "" && s.game != event.Game {
+		return false
+	}
+	return s.player == "" || event.Involves(s.player)
+}
+
+// Follow parses the lines read from r as they come, publishing their events to the /events
+// subscribers and serving the games parsed so far. It returns when r is exhausted or ctx is done,
+// uploads to /parse being rejected until then.
+func (s *Server) Follow(ctx context.Context, r io.Reader) error {
+	p := parser.Parser{OnEvent: s.hub.publish, Metrics: s.Metrics}
+
+	s.mu.Lock()
+	s.following = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.following = false
+		s.mu.Unlock()
+	}()
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		s.mu.Lock()
+		p.ParseLine(scanner.Text())
+		s.games = p.Games()
+		s.mu.Unlock()
+	}
+	return scanner.Err()
+}
+
+// events streams the game events as Server-Sent Events, optionally filtered by game and player.
+func (s *Server) events(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("streaming not supported"))
+		return
+	}
+
+	sub := s.hub.subscribe(r.URL.Query().Get("game"), r.URL.Query().Get("player"))
+	defer s.hub.unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event := <-sub.events:
+			data, _ := json.Marshal(event)
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,149 @@
+//go:build unit
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"qgames/parser"
+)
+
+func TestHub_publish(t *testing.T) {
+	h := newHub()
+	all := h.subscribe("", "")
+	game := h.subscribe("game_02", "")
+	player := h.subscribe("", "Zeh")
+
+	h.publish(parser.Event{Type: parser.EventKill, Game: "game_01", Killer: "Zeh", Victim: "Mal"})
+	h.publish(parser.Event{Type: parser.EventJoin, Game: "game_02", Player: "Mal"})
+
+	assert.Len(t, all.events, 2)
+	assert.Len(t, game.events, 1)
+	assert.Len(t, player.events, 1)
+
+	h.unsubscribe(all)
+	h.publish(parser.Event{Type: parser.EventGameEnd, Game: "game_02"})
+	assert.Len(t, all.events, 2)
+	assert.Len(t, game.events, 2)
+}
+
+func TestHub_publishSlowSubscriber(t *testing.T) {
+	h := newHub()
+	sub := h.subscribe("", "")
+	for i := 0; i < subscriberBuffer+10; i++ {
+		h.publish(parser.Event{Type: parser.EventKill})
+	}
+	assert.Len(t, sub.events, subscriberBuffer)
+}
+
+func TestServer_Follow(t *testing.T) {
+	s := New(nil)
+	sub := s.hub.subscribe("", "Isgalamido")
+
+	err := s.Follow(context.Background(), strings.NewReader(testLog))
+	assert.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/games/game_01", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	types := make([]string, 0)
+	for len(sub.events) > 0 {
+		types = append(types, (<-sub.events).Type)
+	}
+	assert.Equal(t, []string{parser.EventJoin, parser.EventKill, parser.EventLeave}, types)
+}
+
+func TestServer_Follow_rejectsUploads(t *testing.T) {
+	s := New(nil)
+	r, w := io.Pipe()
+	done := make(chan error)
+	go func() { done <- s.Follow(context.Background(), r) }()
+
+	upload := func() int {
+		rec := httptest.NewRecorder()
+		s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/parse", strings.NewReader(testLog)))
+		return rec.Code
+	}
+
+	// The line is written once Follow reads it, so it is following by then.
+	_, err := io.WriteString(w, strings.SplitAfter(testLog, "\n")[0])
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, upload())
+
+	require.NoError(t, w.Close())
+	require.NoError(t, <-done)
+	assert.Equal(t, http.StatusOK, upload())
+}
+
+func TestServer_events(t *testing.T) {
+	s := New(nil)
+	ts := httptest.NewServer(s.Handler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/events?game=game_01&player=Zeh")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// The subscription is registered before the headers are flushed.
+	s.hub.publish(parser.Event{Type: parser.EventKill, Game: "game_01", Killer: "Isgalamido", Victim: "Zeh", Means: "MOD_ROCKET"})
+
+	reader := bufio.NewReader(resp.Body)
+	eventLine, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	dataLine, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "event: kill\n", eventLine)
+	assert.Equal(t, `data: {"type":"kill","game":"game_01","time":0,"killer":"Isgalamido","victim":"Zeh","means":"MOD_ROCKET"}`+"\n", dataLine)
+}
+
+func TestTail(t *testing.T) {
+	file := &growingFile{}
+	ctx, cancel := context.WithCancel(context.Background())
+	tail := Tail(ctx, file, time.Millisecond)
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		file.Write("first line\n")
+	}()
+
+	buf := make([]byte, 64)
+	n, err := tail.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "first line\n", string(buf[:n]))
+
+	cancel()
+	_, err = tail.Read(buf)
+	assert.Equal(t, io.EOF, err)
+}
+
+// growingFile reads like a file being appended to: io.EOF until more data is written.
+type growingFile struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (g *growingFile) Read(b []byte) (int, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.buf.Read(b)
+}
+
+func (g *growingFile) Write(data string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.buf.WriteString(data)
+}
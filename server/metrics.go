@@ -0,0 +1,55 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// metrics writes the parser metrics in the Prometheus text exposition format.
+func (s *Server) metrics(w http.ResponseWriter, r *http.Request) {
+	snapshot := s.Metrics.Snapshot()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	writeMetric(w, "qgames_lines_total", "counter", "Log lines processed.", "", map[string]int{"": snapshot.Lines})
+	writeMetric(w, "qgames_lines_by_type_total", "counter", "Log lines processed, by event type.", "type", snapshot.LinesByType)
+	writeMetric(w, "qgames_malformed_lines_total", "counter", "Log lines that could not be parsed.", "", map[string]int{"": snapshot.MalformedLines})
+	writeMetric(w, "qgames_games_total", "counter", "Games parsed.", "", map[string]int{"": snapshot.Games})
+	writeMetric(w, "qgames_kills_total", "counter", "Kills, by means of death.", "means", snapshot.KillsByMeans)
+	writeMetric(w, "qgames_active_players", "gauge", "Players connected to the game being parsed.", "", map[string]int{"": snapshot.ActivePlayers})
+
+	fmt.Fprintln(w, "# HELP qgames_line_parse_duration_seconds Time spent parsing log lines.")
+	fmt.Fprintln(w, "# TYPE qgames_line_parse_duration_seconds summary")
+	fmt.Fprintf(w, "qgames_line_parse_duration_seconds_sum %g\n", snapshot.ParseTime.Seconds())
+	fmt.Fprintf(w, "qgames_line_parse_duration_seconds_count %d\n", snapshot.Lines)
+}
+
+// writeMetric writes a metric with its samples, by value of its label. Metrics without label
+// have a single sample under the empty key.
+func writeMetric(w io.Writer, name, metricType, help, label string, samples map[string]int) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, metricType)
+
+	if label == "" {
+		fmt.Fprintf(w, "%s %d\n", name, samples[""])
+		return
+	}
+
+	values := make([]string, 0, len(samples))
+	for value := range samples {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
+	for _, value := range values {
+		fmt.Fprintf(w, "%s{%s=\"%s\"} %d\n", name, label, escapeLabelValue(value), samples[value])
+	}
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
@@ -0,0 +1,72 @@
+//go:build unit
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_metrics(t *testing.T) {
+	s := New(nil)
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/parse", strings.NewReader(testLog)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rec.Header().Get("Content-Type"))
+
+	body := rec.Body.String()
+	for _, sample := range []string{
+		"# TYPE qgames_lines_total counter\nqgames_lines_total 7\n",
+		`qgames_lines_by_type_total{type="ClientConnect"} 2` + "\n",
+		`qgames_lines_by_type_total{type="Kill"} 1` + "\n",
+		"qgames_malformed_lines_total 0\n",
+		"qgames_games_total 1\n",
+		`qgames_kills_total{means="MOD_ROCKET"} 1` + "\n",
+		"# TYPE qgames_active_players gauge\n",
+		"qgames_line_parse_duration_seconds_count 7\n",
+	} {
+		assert.Contains(t, body, sample)
+	}
+}
+
+func Test_writeMetric(t *testing.T) {
+	tests := []struct {
+		name    string
+		label   string
+		samples map[string]int
+		want    string
+	}{
+		{
+			name:    "Without label",
+			samples: map[string]int{"": 3},
+			want:    "# HELP test_total Test.\n# TYPE test_total counter\ntest_total 3\n",
+		},
+		{
+			name:    "With label",
+			label:   "means",
+			samples: map[string]int{"MOD_ROCKET": 2, "MOD_BFG": 1},
+			want:    "# HELP test_total Test.\n# TYPE test_total counter\ntest_total{means=\"MOD_BFG\"} 1\ntest_total{means=\"MOD_ROCKET\"} 2\n",
+		},
+		{
+			name:    "Escaped label value",
+			label:   "type",
+			samples: map[string]int{"a\"b\\c\nd": 1},
+			want:    "# HELP test_total Test.\n# TYPE test_total counter\ntest_total{type=\"a\\\"b\\\\c\\nd\"} 1\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &strings.Builder{}
+			writeMetric(w, "test_total", "counter", "Test.", tt.label, tt.samples)
+			assert.Equal(t, tt.want, w.String())
+		})
+	}
+}
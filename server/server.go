@@ -0,0 +1,178 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"qgames/parser"
+)
+
+// Server exposes the games of a parsed log over HTTP. Uploading a log to /parse
+// replaces the games served by the other endpoints, unless the server is following a log.
+type Server struct {
+	// Metrics counts the activity of the parsers of the server, exposed on /metrics.
+	Metrics *parser.Metrics
+
+	mu    sync.RWMutex
+	games map[string]parser.Game
+	// following is set while Follow parses a log, whose games the uploads would be overwritten by.
+	following bool
+	hub       *hub
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// maxUploadSize caps the size of the logs uploaded to /parse.
+const maxUploadSize = 512 << 20
+
+var errFollowing = errors.New("the server is following a log, uploads are disabled")
+
+func New(games map[string]parser.Game) *Server {
+	if games == nil {
+		games = make(map[string]parser.Game)
+	}
+	return &Server{Metrics: parser.NewMetrics(), games: games, hub: newHub()}
+}
+
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /parse", s.parse)
+	mux.HandleFunc("GET /games", s.listGames)
+	mux.HandleFunc("GET /games/{id}", s.getGame)
+	mux.HandleFunc("GET /players/{name}", s.getPlayer)
+	mux.HandleFunc("GET /ranking", s.ranking)
+	mux.HandleFunc("GET /matrix", s.matrix)
+	mux.HandleFunc("GET /ratings", s.ratings)
+	mux.HandleFunc("GET /events", s.events)
+	mux.HandleFunc("GET /metrics", s.metrics)
+	return mux
+}
+
+// parse accepts the log either as the raw request body or as the "log" file of a multipart form.
+func (s *Server) parse(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+
+	var body io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		file, _, err := r.FormFile("log")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	s.mu.RLock()
+	following := s.following
+	s.mu.RUnlock()
+	if following {
+		writeError(w, http.StatusConflict, errFollowing)
+		return
+	}
+
+	p := parser.Parser{Metrics: s.Metrics}
+	games, err := p.ParseReader(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	s.mu.Lock()
+	following = s.following
+	if !following {
+		s.games = games
+	}
+	s.mu.Unlock()
+	if following {
+		writeError(w, http.StatusConflict, errFollowing)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, games)
+}
+
+func (s *Server) listGames(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	writeJSON(w, http.StatusOK, s.games)
+}
+
+func (s *Server) getGame(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	game, ok := s.games[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("game not found"))
+		return
+	}
+	writeJSON(w, http.StatusOK, game)
+}
+
+func (s *Server) getPlayer(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	player, ok := parser.Players(s.games)[r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("player not found"))
+		return
+	}
+	writeJSON(w, http.StatusOK, player)
+}
+
+func (s *Server) ranking(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	writeJSON(w, http.StatusOK, parser.Ranking(s.games))
+}
+
+func (s *Server) ratings(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	writeJSON(w, http.StatusOK, parser.Ratings(s.games, nil))
+}
+
+// matrix serves the kill matrix of every game, or of a single game with the game query parameter,
+// as JSON or, with format=csv, as CSV.
+func (s *Server) matrix(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matrix := parser.OverallKillMatrix(s.games)
+	if key := r.URL.Query().Get("game"); key != "" {
+		game, ok := s.games[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, errors.New("game not found"))
+			return
+		}
+		matrix = game.KillMatrix
+	}
+
+	if r.URL.Query().Get("format") == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		_ = matrix.WriteCSV(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, matrix)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
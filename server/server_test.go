@@ -0,0 +1,199 @@
+//go:build unit
+
+package server
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"qgames/parser"
+)
+
+const testLog = `  0:00 InitGame: \sv_floodProtect\1\g_gametype\0\mapname\q3dm17
+ 20:34 ClientConnect: 2
+ 20:34 ClientUserinfoChanged: 2 n\Isgalamido\t\0\model\xian/default
+ 20:35 ClientConnect: 3
+ 20:35 ClientUserinfoChanged: 3 n\Zeh\t\0\model\sarge
+ 20:54 Kill: 2 3 6: Isgalamido killed Zeh by MOD_ROCKET
+ 20:55 ShutdownGame:
+`
+
+func newTestServer() *Server {
+	return New(map[string]parser.Game{
+		"game_01": {
+			Map:        "q3dm17",
+			TotalKills: 1,
+			Players:    []string{"Isgalamido", "Zeh"},
+			Kills:      map[string]int{"Isgalamido": 1},
+			KillMatrix: parser.KillMatrix{"Isgalamido": {"Zeh": 1}},
+		},
+	})
+}
+
+func TestServer_Handler(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "List games",
+			method:     http.MethodGet,
+			target:     "/games",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"game_01":{"map":"q3dm17","total_kills":1,"players":["Isgalamido","Zeh"],"names":null,"kills":{"Isgalamido":1},"kills_by_means":null,"items_by_category":null,"items_by_player":null,"chat":null,"connections":null,"kill_matrix":{"Isgalamido":{"Zeh":1}}}}`,
+		},
+		{
+			name:       "Get game",
+			method:     http.MethodGet,
+			target:     "/games/game_01",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"map":"q3dm17","total_kills":1,"players":["Isgalamido","Zeh"],"names":null,"kills":{"Isgalamido":1},"kills_by_means":null,"items_by_category":null,"items_by_player":null,"chat":null,"connections":null,"kill_matrix":{"Isgalamido":{"Zeh":1}}}`,
+		},
+		{
+			name:       "Game not found",
+			method:     http.MethodGet,
+			target:     "/games/game_02",
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error":"game not found"}`,
+		},
+		{
+			name:       "Get player",
+			method:     http.MethodGet,
+			target:     "/players/Zeh",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"player":"Zeh","games":["game_01"],"kills":0}`,
+		},
+		{
+			name:       "Player not found",
+			method:     http.MethodGet,
+			target:     "/players/Mal",
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error":"player not found"}`,
+		},
+		{
+			name:       "Ranking",
+			method:     http.MethodGet,
+			target:     "/ranking",
+			wantStatus: http.StatusOK,
+			wantBody:   `[{"player":"Isgalamido","games":["game_01"],"kills":1},{"player":"Zeh","games":["game_01"],"kills":0}]`,
+		},
+		{
+			name:       "Ratings",
+			method:     http.MethodGet,
+			target:     "/ratings",
+			wantStatus: http.StatusOK,
+			wantBody:   `[{"player":"Isgalamido","rating":1508,"games":1,"history":[{"game":"game_01","rating":1508,"change":8}]},{"player":"Zeh","rating":1492,"games":1,"history":[{"game":"game_01","rating":1492,"change":-8}]}]`,
+		},
+		{
+			name:       "Kill matrix",
+			method:     http.MethodGet,
+			target:     "/matrix",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"Isgalamido":{"Zeh":1}}`,
+		},
+		{
+			name:       "Kill matrix of game",
+			method:     http.MethodGet,
+			target:     "/matrix?game=game_01",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"Isgalamido":{"Zeh":1}}`,
+		},
+		{
+			name:       "Kill matrix of missing game",
+			method:     http.MethodGet,
+			target:     "/matrix?game=game_02",
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error":"game not found"}`,
+		},
+		{
+			name:       "Method not allowed",
+			method:     http.MethodDelete,
+			target:     "/games",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			newTestServer().Handler().ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestServer_matrixCSV(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newTestServer().Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/matrix?format=csv", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "killer\\victim,Isgalamido,Zeh\nIsgalamido,0,1\nZeh,0,0\n", rec.Body.String())
+}
+
+func TestServer_parse(t *testing.T) {
+	multipartBody := func() (*bytes.Buffer, string) {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, _ := writer.CreateFormFile("log", "games.log")
+		_, _ = part.Write([]byte(testLog))
+		_ = writer.Close()
+		return body, writer.FormDataContentType()
+	}
+
+	tests := []struct {
+		name        string
+		body        func() (*bytes.Buffer, string)
+		wantStatus  int
+		wantRanking string
+	}{
+		{
+			name:        "Raw body",
+			body:        func() (*bytes.Buffer, string) { return bytes.NewBufferString(testLog), "text/plain" },
+			wantStatus:  http.StatusOK,
+			wantRanking: `[{"player":"Isgalamido","games":["game_01"],"kills":1},{"player":"Zeh","games":["game_01"],"kills":0}]`,
+		},
+		{
+			name:        "Multipart form",
+			body:        multipartBody,
+			wantStatus:  http.StatusOK,
+			wantRanking: `[{"player":"Isgalamido","games":["game_01"],"kills":1},{"player":"Zeh","games":["game_01"],"kills":0}]`,
+		},
+		{
+			name: "Multipart form without log",
+			body: func() (*bytes.Buffer, string) {
+				body := &bytes.Buffer{}
+				writer := multipart.NewWriter(body)
+				_ = writer.Close()
+				return body, writer.FormDataContentType()
+			},
+			wantStatus:  http.StatusBadRequest,
+			wantRanking: `[]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(nil)
+			body, contentType := tt.body()
+			req := httptest.NewRequest(http.MethodPost, "/parse", body)
+			req.Header.Set("Content-Type", contentType)
+
+			rec := httptest.NewRecorder()
+			s.Handler().ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			rec = httptest.NewRecorder()
+			s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ranking", nil))
+			assert.JSONEq(t, tt.wantRanking, rec.Body.String())
+		})
+	}
+}
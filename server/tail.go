@@ -0,0 +1,35 @@
+package server
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// tailReader reads a growing file: at the end of the file it waits for new data
+// instead of returning io.EOF, until its context is done.
+type tailReader struct {
+	ctx      context.Context
+	r        io.Reader
+	interval time.Duration
+}
+
+// Tail returns a reader following r as it grows, polling for new data every interval.
+func Tail(ctx context.Context, r io.Reader, interval time.Duration) io.Reader {
+	return &tailReader{ctx: ctx, r: r, interval: interval}
+}
+
+func (t *tailReader) Read(b []byte) (int, error) {
+	for {
+		n, err := t.r.Read(b)
+		if n > 0 || err != io.EOF {
+			return n, err
+		}
+
+		select {
+		case <-t.ctx.Done():
+			return 0, io.EOF
+		case <-time.After(t.interval):
+		}
+	}
+}
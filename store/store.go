@@ -0,0 +1,225 @@
+// Package store persists parsed games to an embedded SQLite database, for ad-hoc SQL across logs.
+package store
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"hash"
+	"io"
+
+	_ "modernc.org/sqlite"
+
+	"qgames/parser"
+)
+
+// schema creates the tables, keeping those of an existing database. Games are identified by the
+// content hash of their lines, from their InitGame line to the line ending them, so importing the
+// same log twice does not duplicate them.
+const schema = `
+CREATE TABLE IF NOT EXISTS games (
+	id          INTEGER PRIMARY KEY,
+	hash        TEXT    NOT NULL UNIQUE,
+	game        TEXT    NOT NULL,
+	map         TEXT    NOT NULL,
+	total_kills INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS players (
+	game_id        INTEGER NOT NULL REFERENCES games(id),
+	name           TEXT    NOT NULL,
+	kills          INTEGER NOT NULL,
+	team           TEXT,
+	joined_at      INTEGER,
+	left_at        INTEGER,
+	time_on_server INTEGER,
+	reconnects     INTEGER,
+	present_at_end INTEGER,
+	PRIMARY KEY (game_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS kills (
+	game_id INTEGER NOT NULL REFERENCES games(id),
+	seq     INTEGER NOT NULL,
+	time    INTEGER NOT NULL,
+	killer  TEXT    NOT NULL,
+	victim  TEXT    NOT NULL,
+	means   TEXT    NOT NULL,
+	PRIMARY KEY (game_id, seq)
+);
+
+CREATE TABLE IF NOT EXISTS items (
+	game_id  INTEGER NOT NULL REFERENCES games(id),
+	player   TEXT    NOT NULL,
+	category TEXT    NOT NULL,
+	count    INTEGER NOT NULL,
+	PRIMARY KEY (game_id, player, category)
+);
+
+CREATE INDEX IF NOT EXISTS kills_killer ON kills(killer);
+CREATE INDEX IF NOT EXISTS kills_victim ON kills(victim);
+CREATE INDEX IF NOT EXISTS players_name ON players(name);
+`
+
+// Store is a SQLite database of parsed games.
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens the database at path, creating it and its tables if needed.
+func Open(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// DB gives access to the underlying database, for queries.
+func (s *Store) DB() *sql.DB {
+	return s.db
+}
+
+// record is a game being read from the log: the hash of its lines, its kills in order and whether
+// it ended before the log did.
+type record struct {
+	hash  hash.Hash
+	kills []parser.Event
+	ended bool
+}
+
+// Import parses the log and stores its ended games, skipping those already stored. The game the
+// log ends in may go on as the log grows, so it is only stored once a later import reads the
+// ShutdownGame or InitGame line ending it. The log is parsed with p, a parser configured as for
+// the other outputs, aliases and scoring rules included, that has not parsed anything yet. Its
+// OnEvent is replaced during the import. It returns the number of games added.
+func (s *Store) Import(r io.Reader, p *parser.Parser) (int, error) {
+	if err := p.CheckAggregators(); err != nil {
+		return 0, err
+	}
+
+	records := make(map[string]*record)
+	var current *record
+	eof := false
+
+	defer func(onEvent func(parser.Event)) { p.OnEvent = onEvent }(p.OnEvent)
+	p.OnEvent = func(event parser.Event) {
+		switch event.Type {
+		case parser.EventGameStart:
+			current = &record{hash: sha256.New()}
+			records[event.Game] = current
+		case parser.EventKill:
+			if current != nil {
+				current.kills = append(current.kills, event)
+			}
+		case parser.EventGameEnd:
+			if current != nil {
+				current.ended = !eof
+			}
+			current = nil
+		}
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		p.ParseLine(scanner.Text())
+		if current != nil {
+			current.hash.Write(scanner.Bytes())
+			current.hash.Write([]byte("\n"))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	eof = true
+	p.End()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	games := p.Games()
+	added := 0
+	for _, key := range parser.GameKeys(games) {
+		if rec := records[key]; rec == nil || !rec.ended {
+			continue
+		}
+		ok, err := insertGame(tx, key, games[key], records[key])
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			added++
+		}
+	}
+
+	return added, tx.Commit()
+}
+
+// insertGame stores the game unless a game with the same hash is already stored, reporting whether it did.
+func insertGame(tx *sql.Tx, key string, game parser.Game, rec *record) (bool, error) {
+	result, err := tx.Exec(`INSERT INTO games (hash, game, map, total_kills) VALUES (?, ?, ?, ?) ON CONFLICT (hash) DO NOTHING`,
+		hex.EncodeToString(rec.hash.Sum(nil)), key, game.Map, game.TotalKills)
+	if err != nil {
+		return false, err
+	}
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return false, err
+	}
+
+	for _, player := range game.Players {
+		var team sql.NullString
+		if game.Teams != nil {
+			team.String, team.Valid = game.Teams.Players[player]
+		}
+
+		var joinedAt, leftAt, timeOnServer, reconnects sql.NullInt64
+		var presentAtEnd sql.NullBool
+		if conn, ok := game.Connections[player]; ok {
+			joinedAt = sql.NullInt64{Int64: int64(conn.JoinedAt), Valid: true}
+			leftAt = sql.NullInt64{Int64: int64(conn.LeftAt), Valid: true}
+			timeOnServer = sql.NullInt64{Int64: int64(conn.TimeOnServer), Valid: true}
+			reconnects = sql.NullInt64{Int64: int64(conn.Reconnects), Valid: true}
+			presentAtEnd = sql.NullBool{Bool: conn.PresentAtEnd, Valid: true}
+		}
+
+		if _, err := tx.Exec(`INSERT INTO players (game_id, name, kills, team, joined_at, left_at, time_on_server, reconnects, present_at_end)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			id, player, game.Kills[player], team, joinedAt, leftAt, timeOnServer, reconnects, presentAtEnd); err != nil {
+			return false, err
+		}
+	}
+
+	for seq, kill := range rec.kills {
+		if _, err := tx.Exec(`INSERT INTO kills (game_id, seq, time, killer, victim, means) VALUES (?, ?, ?, ?, ?, ?)`,
+			id, seq+1, kill.Time, kill.Killer, kill.Victim, kill.Means); err != nil {
+			return false, err
+		}
+	}
+
+	for player, categories := range game.ItemsByPlayer {
+		for category, count := range categories {
+			if _, err := tx.Exec(`INSERT INTO items (game_id, player, category, count) VALUES (?, ?, ?, ?)`,
+				id, player, category, count); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	return true, nil
+}
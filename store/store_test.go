@@ -0,0 +1,163 @@
+//go:build unit
+
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"qgames/parser"
+)
+
+const testLog = `  0:00 InitGame: \sv_floodProtect\1\g_gametype\0\mapname\q3dm17
+ 20:34 ClientConnect: 2
+ 20:34 ClientUserinfoChanged: 2 n\Isgalamido\t\0\model\xian/default
+ 20:35 ClientConnect: 3
+ 20:35 ClientUserinfoChanged: 3 n\Zeh\t\0\model\sarge
+ 20:40 Item: 2 weapon_rocketlauncher
+ 20:54 Kill: 2 3 6: Isgalamido killed Zeh by MOD_ROCKET
+ 20:58 Kill: 1022 2 22: <world> killed Isgalamido by MOD_TRIGGER_HURT
+ 21:10 ShutdownGame:
+------------------------------------------------------------
+  0:00 InitGame: \sv_floodProtect\1\g_gametype\0\mapname\q3dm17
+  0:10 Kill: broken line
+  0:00 InitGame: \sv_floodProtect\1\g_gametype\3\mapname\q3dm6
+  0:10 ClientConnect: 4
+  0:10 ClientUserinfoChanged: 4 n\Mal\t\1\model\sarge
+  0:20 Kill: 1022 4 19: <world> killed Mal by MOD_FALLING
+  0:30 ShutdownGame:
+`
+
+func openTestStore(t *testing.T) (*Store, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "games.sqlite")
+	s, err := Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { s.Close() })
+	return s, path
+}
+
+func TestStore_Import(t *testing.T) {
+	s, path := openTestStore(t)
+
+	added, err := s.Import(strings.NewReader(testLog), &parser.Parser{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, added)
+
+	added, err = s.Import(strings.NewReader(testLog), &parser.Parser{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, added)
+
+	// A log sharing a game with the imported one only adds its new game.
+	firstGame := testLog[:strings.Index(testLog, "ShutdownGame:\n")+len("ShutdownGame:\n")]
+	added, err = s.Import(strings.NewReader(firstGame+"  0:00 InitGame: \\sv_floodProtect\\1\\g_gametype\\0\\mapname\\q3dm2\n  0:05 ShutdownGame:\n"), &parser.Parser{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, added)
+
+	require.NoError(t, s.Close())
+	s, err = Open(path)
+	require.NoError(t, err)
+	defer s.Close()
+
+	var games []string
+	rows, err := s.DB().Query(`SELECT game || ':' || map || ':' || total_kills FROM games ORDER BY id`)
+	require.NoError(t, err)
+	for rows.Next() {
+		var game string
+		require.NoError(t, rows.Scan(&game))
+		games = append(games, game)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []string{"game_01:q3dm17:2", "game_03:q3dm6:1", "game_02:q3dm2:0"}, games)
+
+	var kills, score, present int
+	var team string
+	require.NoError(t, s.DB().QueryRow(`SELECT COUNT(*) FROM kills WHERE killer = '<world>'`).Scan(&kills))
+	assert.Equal(t, 2, kills)
+	require.NoError(t, s.DB().QueryRow(`SELECT kills, present_at_end FROM players WHERE name = 'Isgalamido'`).Scan(&score, &present))
+	assert.Equal(t, 0, score)
+	assert.Equal(t, 1, present)
+	require.NoError(t, s.DB().QueryRow(`SELECT team FROM players WHERE name = 'Mal'`).Scan(&team))
+	assert.Equal(t, "red", team)
+
+	var means string
+	var time int
+	require.NoError(t, s.DB().QueryRow(`SELECT time, means FROM kills WHERE seq = 1 AND victim = 'Zeh'`).Scan(&time, &means))
+	assert.Equal(t, 1254, time)
+	assert.Equal(t, "MOD_ROCKET", means)
+
+	var count int
+	require.NoError(t, s.DB().QueryRow(`SELECT count FROM items WHERE player = 'Isgalamido' AND category = 'weapon'`).Scan(&count))
+	assert.Equal(t, 1, count)
+}
+
+func TestStore_Import_growingLog(t *testing.T) {
+	s, _ := openTestStore(t)
+	count := func() int {
+		var games int
+		require.NoError(t, s.DB().QueryRow(`SELECT COUNT(*) FROM games`).Scan(&games))
+		return games
+	}
+
+	// The log is cut within the first game, then after its ShutdownGame line, then grows on.
+	cuts := []struct {
+		end       string
+		wantAdded int
+		wantGames int
+	}{
+		{end: " 20:54 Kill:", wantAdded: 0, wantGames: 0},
+		{end: " 21:10 ShutdownGame:\n", wantAdded: 1, wantGames: 1},
+		{end: "  0:10 Kill: broken line\n", wantAdded: 0, wantGames: 1},
+		{end: "  0:20 Kill: 1022 4 19: <world> killed Mal by MOD_FALLING\n", wantAdded: 0, wantGames: 1},
+		{end: "  0:30 ShutdownGame:\n", wantAdded: 1, wantGames: 2},
+	}
+	for _, cut := range cuts {
+		prefix := testLog[:strings.Index(testLog, cut.end)+len(cut.end)]
+		added, err := s.Import(strings.NewReader(prefix), &parser.Parser{})
+		require.NoError(t, err)
+		assert.Equal(t, cut.wantAdded, added, cut.end)
+		assert.Equal(t, cut.wantGames, count(), cut.end)
+	}
+
+	added, err := s.Import(strings.NewReader(testLog), &parser.Parser{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, added)
+	assert.Equal(t, 2, count())
+}
+
+func TestStore_Import_configured(t *testing.T) {
+	s, _ := openTestStore(t)
+
+	p := parser.Parser{
+		Aliases: parser.Aliases{"Isgalamido": "Isga"},
+		Scoring: &parser.ScoringRules{Kill: 3, WorldDeath: -2},
+	}
+	_, err := s.Import(strings.NewReader(testLog), &p)
+	require.NoError(t, err)
+	assert.Nil(t, p.OnEvent)
+
+	var score int
+	require.NoError(t, s.DB().QueryRow(`SELECT kills FROM players WHERE name = 'Isga'`).Scan(&score))
+	assert.Equal(t, 1, score)
+	var kills int
+	require.NoError(t, s.DB().QueryRow(`SELECT COUNT(*) FROM kills WHERE killer = 'Isga' OR victim = 'Isga'`).Scan(&kills))
+	assert.Equal(t, 2, kills)
+	require.NoError(t, s.DB().QueryRow(`SELECT COUNT(*) FROM players WHERE name = 'Isgalamido'`).Scan(&kills))
+	assert.Equal(t, 0, kills)
+}
+
+func TestOpen(t *testing.T) {
+	_, err := Open(filepath.Join(t.TempDir(), "missing", "games.sqlite"))
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "games.sqlite")
+	require.NoError(t, os.WriteFile(path, []byte("not a database"), 0o644))
+	_, err = Open(path)
+	assert.Error(t, err)
+}